@@ -27,6 +27,13 @@ import (
 
 func main() {
 	flag.Parse()
+	// step: merge in the configuration file and environment, then validate
+	if err := config.Load(flag.CommandLine, os.Environ()); err != nil {
+		glog.Fatalf("Failed to load the configuration, error: %s", err)
+	}
+	if err := config.Options.Validate(); err != nil {
+		glog.Fatalf("Failed to validate the configuration, error: %s", err)
+	}
 
 	glog.Infof("Starting the Config Hook Service, version: %s (author: %s)", VERSION, config.AUTHOR)
 
@@ -34,7 +41,7 @@ func main() {
 		glog.Fatalf("Failed to create the hook service, error: %s", err)
 	} else {
 		// step: we wait for any kill signals
-		signalChannel := make(chan os.Signal)
+		signalChannel := make(chan os.Signal, 1)
 		signal.Notify(signalChannel, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 		// step: wait on the signal
 		<-signalChannel
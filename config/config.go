@@ -14,15 +14,28 @@ limitations under the License.
 package config
 
 import (
+	"errors"
 	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 )
 
 const (
 	AUTHOR                 = "Rohith <gambol99@gmail.com>"
 	NAME                   = "Config Hook Service"
 	DEFAULT_RUNTIME_PREFIX = "CONFIG_HOOK_"
+	DEFAULT_LABEL_PREFIX   = "config-hook"
 	DEFAULT_DOCKER_SOCKET  = "/var/run/docker.sock"
 	DEFAULT_STORE_URL      = "etcd://127.0.0.1:4001"
+	DEFAULT_CLEANUP        = "keep"
+	DEFAULT_SHUTDOWN       = 30 * time.Second
+	DEFAULT_RECONCILE      = 5 * time.Minute
+	DEFAULT_OWNER_PREFIX   = "/config-hook/owners"
+	DEFAULT_META_PREFIX    = "/config-hook/meta"
+	DEFAULT_CONFLICT       = "last"
+	DEFAULT_MAX_SIZE       = 1024 * 1024
 )
 
 // the configuration options for the service
@@ -31,8 +44,76 @@ type ConfigHookOptions struct {
 	Docker_Socket string
 	// the runtime variable used to indicate configuration resolve
 	Runtime_Prefix string
+	// the prefix used on the docker labels to indicate configuration resolve
+	Label_Prefix string
 	// the url location of the store
 	Store_URL string
+	// the agent variables used for substitution in the hooks
+	Variables Variables
+	// the default cleanup policy for keys when a container is destroyed
+	Cleanup string
+	// the default interval to re-read the hook files for changes
+	Interval time.Duration
+	// the default ttl of the keys published, kept alive while the container is running
+	TTL time.Duration
+	// the path in the store where the owners of the published keys are recorded
+	Owner_Prefix string
+	// the policy when a key is owned by another container, first, last or refuse
+	Conflict string
+	// the path in the store where the metadata of the encoded keys is recorded
+	Meta_Prefix string
+	// the maximum size of the content read from a container for a hook
+	Max_Size int64
+	// the interface and port the status api listens on
+	Listen string
+	// the interval between reconciling the running containers with the managed hooks
+	Reconcile time.Duration
+	// the maximum time to wait for in-flight work when shutting down
+	Shutdown_Timeout time.Duration
+	// the path to the configuration file for the agent
+	Config_File string
+	// the options for the etcd backend
+	Etcd TLSOptions
+	// the options for the consul backend
+	Consul ConsulOptions
+}
+
+// The TLS options for connecting to a store backend
+type TLSOptions struct {
+	// the client certificate file
+	Cert_File string
+	// the client key file
+	Key_File string
+	// the ca certificate file, enables TLS when set
+	CACert_File string
+}
+
+// The options for the consul backend
+type ConsulOptions struct {
+	TLSOptions
+	// the acl token used when accessing the kv store
+	Token string
+}
+
+// A map of variables which can be set multiple times on the command line as KEY=VALUE
+type Variables map[string]string
+
+func (r Variables) String() string {
+	list := make([]string, 0)
+	for key, value := range r {
+		list = append(list, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
+
+func (r Variables) Set(value string) error {
+	elements := strings.SplitN(value, "=", 2)
+	if len(elements) != 2 || elements[0] == "" {
+		return errors.New("the variable: " + value + " should be in the format KEY=VALUE")
+	}
+	r[elements[0]] = elements[1]
+	return nil
 }
 
 var Options ConfigHookOptions
@@ -40,5 +121,26 @@ var Options ConfigHookOptions
 func init() {
 	flag.StringVar(&Options.Docker_Socket, "docker", DEFAULT_DOCKER_SOCKET, "the path to the docker socket file")
 	flag.StringVar(&Options.Runtime_Prefix, "prefix", DEFAULT_RUNTIME_PREFIX, "the runtime prefix read from the docker env variables to indicate configs inside")
+	flag.StringVar(&Options.Label_Prefix, "label-prefix", DEFAULT_LABEL_PREFIX, "the prefix read from the image and container labels to indicate configs inside")
+	Options.Variables = make(Variables, 0)
+	flag.Var(Options.Variables, "variable", "a KEY=VALUE variable used to substitute %KEY% in the hooks, can be used multiple times")
 	flag.StringVar(&Options.Store_URL, "store", DEFAULT_STORE_URL, "the url for the k/v store used to push configurations")
+	flag.StringVar(&Options.Listen, "listen", "", "the interface and port for the status api, i.e. :8080 (disabled if empty)")
+	flag.DurationVar(&Options.Interval, "interval", 0, "the default interval to re-read hook files in the containers for changes, 0 to disable")
+	flag.DurationVar(&Options.TTL, "ttl", 0, "the default ttl of the keys published, refreshed while the container is running, 0 to disable")
+	flag.StringVar(&Options.Owner_Prefix, "owner-prefix", DEFAULT_OWNER_PREFIX, "the path in the store to record the owners of the published keys, empty to disable")
+	flag.StringVar(&Options.Meta_Prefix, "meta-prefix", DEFAULT_META_PREFIX, "the path in the store to record the encoding and content type of binary keys, empty to disable")
+	flag.StringVar(&Options.Conflict, "conflict", DEFAULT_CONFLICT, "the policy when a key is owned by another container, first, last or refuse")
+	flag.Int64Var(&Options.Max_Size, "max-size", DEFAULT_MAX_SIZE, "the maximum size in bytes of the content read from a container for a hook, 0 for no limit")
+	flag.StringVar(&Options.Cleanup, "cleanup", DEFAULT_CLEANUP, "the default policy for keys when a container is destroyed, keep, delete or remove")
+	flag.DurationVar(&Options.Reconcile, "reconcile", DEFAULT_RECONCILE, "the interval to reconcile the containers and published keys with the managed hooks, 0 to disable")
+	flag.DurationVar(&Options.Shutdown_Timeout, "shutdown-timeout", DEFAULT_SHUTDOWN, "the maximum time to wait for queued publishes and running execs when shutting down")
+	flag.StringVar(&Options.Config_File, "config", "", "the path to a yaml or json configuration file for the agent (optional)")
+	flag.StringVar(&Options.Etcd.Cert_File, "etcd-cert", "", "the etcd certificate file (optional)")
+	flag.StringVar(&Options.Etcd.Key_File, "etcd-keycert", "", "the etcd key certificate file (optional)")
+	flag.StringVar(&Options.Etcd.CACert_File, "etcd-cacert", "", "the etcd ca certificate file (optional)")
+	flag.StringVar(&Options.Consul.Token, "consul-token", "", "the consul acl token used when accessing the kv store (optional)")
+	flag.StringVar(&Options.Consul.Cert_File, "consul-cert", "", "the consul client certificate file (optional)")
+	flag.StringVar(&Options.Consul.Key_File, "consul-keycert", "", "the consul client key certificate file (optional)")
+	flag.StringVar(&Options.Consul.CACert_File, "consul-cacert", "", "the consul ca certificate file (optional)")
 }
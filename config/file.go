@@ -0,0 +1,454 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// the prefix of the environment variables used to configure the agent
+	ENV_PREFIX = "CONFIG_HOOK_AGENT_"
+)
+
+// the names used in the configuration file which differ from the flags
+var file_aliases = map[string]string{
+	"variables": "variable",
+}
+
+// Loads the configuration for the agent; the options are merged in order of precedence, the
+// defaults, the configuration file, the environment and then the command line flags
+//	flags:		the parsed command line flags
+//	environ:	the environment of the agent, i.e. os.Environ()
+func Load(flags *flag.FlagSet, environ []string) error {
+	// step: we don't override anything given explicitly on the command line
+	explicit := make(map[string]bool, 0)
+	flags.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+	environment := parseEnviron(environ)
+
+	// step: find the configuration file, if any
+	filename := ""
+	if f := flags.Lookup("config"); f != nil {
+		filename = f.Value.String()
+		if value, found := environment[envName("config")]; found && !explicit["config"] {
+			filename = value
+		}
+	}
+	if filename != "" {
+		values, err := readConfigFile(filename)
+		if err != nil {
+			return err
+		}
+		if err := applyValues(flags, explicit, values); err != nil {
+			return fmt.Errorf("invalid configuration file: %s, %s", filename, err)
+		}
+	}
+
+	// step: apply any environment overrides
+	if err := applyEnvironment(flags, explicit, environment); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validates the merged options, returning all the problems found
+func (r ConfigHookOptions) Validate() error {
+	problems := make([]string, 0)
+	if r.Docker_Socket == "" {
+		problems = append(problems, "the docker socket must be set")
+	}
+	if r.Runtime_Prefix == "" {
+		problems = append(problems, "the runtime prefix must be set")
+	}
+	if r.Label_Prefix == "" {
+		problems = append(problems, "the label prefix must be set")
+	}
+	if location, err := url.Parse(r.Store_URL); err != nil || location.Scheme == "" {
+		problems = append(problems, "the store url: "+r.Store_URL+" is invalid, expected i.e. etcd://127.0.0.1:4001")
+	}
+	if r.Interval < 0 {
+		problems = append(problems, "the interval: "+r.Interval.String()+" cannot be negative")
+	}
+	if r.Max_Size < 0 {
+		problems = append(problems, fmt.Sprintf("the maximum size: %d cannot be negative", r.Max_Size))
+	}
+	if r.TTL < 0 {
+		problems = append(problems, "the ttl: "+r.TTL.String()+" cannot be negative")
+	}
+	if r.Reconcile < 0 {
+		problems = append(problems, "the reconcile interval: "+r.Reconcile.String()+" cannot be negative")
+	}
+	if r.Shutdown_Timeout < 0 {
+		problems = append(problems, "the shutdown timeout: "+r.Shutdown_Timeout.String()+" cannot be negative")
+	}
+	if r.Listen != "" {
+		if _, _, err := net.SplitHostPort(r.Listen); err != nil {
+			problems = append(problems, "the listen address: "+r.Listen+" is invalid, "+err.Error())
+		}
+	}
+	if !isChoice(r.Cleanup, "keep", "delete", "remove") {
+		problems = append(problems, "the cleanup policy: "+r.Cleanup+" is invalid, must be keep, delete or remove")
+	}
+	if !isChoice(r.Conflict, "first", "last", "refuse") {
+		problems = append(problems, "the conflict policy: "+r.Conflict+" is invalid, must be first, last or refuse")
+	}
+	problems = append(problems, r.Etcd.validate("etcd")...)
+	problems = append(problems, r.Consul.validate("consul")...)
+	if len(problems) > 0 {
+		return errors.New("invalid configuration: " + strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// Checks if the value is one of the choices, ignoring the case and any surrounding spaces
+//	value:		the value given
+//	choices:	the valid values
+func isChoice(value string, choices ...string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, choice := range choices {
+		if value == choice {
+			return true
+		}
+	}
+	return false
+}
+
+// Validates the TLS options for a backend
+//	backend:	the name of the backend, i.e. etcd
+func (r TLSOptions) validate(backend string) []string {
+	problems := make([]string, 0)
+	if (r.Cert_File == "") != (r.Key_File == "") {
+		problems = append(problems, "the "+backend+" certificate and key must be set together")
+	}
+	for _, filename := range []string{r.Cert_File, r.Key_File, r.CACert_File} {
+		if filename == "" {
+			continue
+		}
+		if _, err := os.Stat(filename); err != nil {
+			problems = append(problems, "the "+backend+" tls file: "+filename+" is not accessible, "+err.Error())
+		}
+	}
+	return problems
+}
+
+// Reads the configuration file, the format is determined by the extension
+//	filename:	the path to the yaml or json configuration file
+func readConfigFile(filename string) (map[string]interface{}, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the configuration file: %s, %s", filename, err)
+	}
+	values := make(map[string]interface{}, 0)
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		err = json.Unmarshal(content, &values)
+	case ".yaml", ".yml":
+		values, err = ParseYAML(string(content))
+	default:
+		if strings.HasPrefix(strings.TrimSpace(string(content)), "{") {
+			err = json.Unmarshal(content, &values)
+		} else {
+			values, err = ParseYAML(string(content))
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the configuration file: %s, %s", filename, err)
+	}
+	return values, nil
+}
+
+// Parses a yaml document, which must be a map; the values are returned in the same form as
+// encoding/json, though the scalars other than booleans and nulls are kept as strings
+//	content:	the yaml document
+func ParseYAML(content string) (map[string]interface{}, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return nil, err
+	}
+	if len(document.Content) == 0 {
+		return make(map[string]interface{}, 0), nil
+	}
+	value, err := yamlValue(document.Content[0], make(map[*yaml.Node]bool, 0))
+	if err != nil {
+		return nil, err
+	}
+	values, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("line %d: the document must be a map of options", document.Content[0].Line)
+	}
+	return values, nil
+}
+
+// Converts a node from the yaml document, resolving any aliases to their anchors
+//	node:		the yaml node to convert
+//	parents:	the nodes being converted, used to reject an anchor which contains itself
+func yamlValue(node *yaml.Node, parents map[*yaml.Node]bool) (interface{}, error) {
+	if parents[node] {
+		return nil, fmt.Errorf("line %d: the anchor: %s contains itself", node.Line, node.Anchor)
+	}
+	parents[node] = true
+	defer delete(parents, node)
+	switch node.Kind {
+	case yaml.AliasNode:
+		return yamlValue(node.Alias, parents)
+	case yaml.MappingNode:
+		values := make(map[string]interface{}, 0)
+		merges := make([]*yaml.Node, 0)
+		for index := 0; index+1 < len(node.Content); index += 2 {
+			key, element := node.Content[index], node.Content[index+1]
+			if key.Tag == "!!merge" {
+				merges = append(merges, element)
+				continue
+			}
+			if key.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("line %d: the keys must be scalars", key.Line)
+			}
+			if _, found := values[key.Value]; found {
+				return nil, fmt.Errorf("line %d: the key: %s is duplicated", key.Line, key.Value)
+			}
+			value, err := yamlValue(element, parents)
+			if err != nil {
+				return nil, err
+			}
+			values[key.Value] = value
+		}
+		// step: merge in the values of the anchors referenced by <<, the keys given take precedence
+		for _, merge := range merges {
+			if err := yamlMerge(values, merge, parents); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	case yaml.SequenceNode:
+		values := make([]interface{}, 0)
+		for _, element := range node.Content {
+			value, err := yamlValue(element, parents)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			return nil, nil
+		case "!!bool":
+			var value bool
+			if err := node.Decode(&value); err != nil {
+				return nil, err
+			}
+			return value, nil
+		}
+		return node.Value, nil
+	}
+	return nil, fmt.Errorf("line %d: unsupported yaml node", node.Line)
+}
+
+// Merges the values of a merge key into a map, the keys already present are kept
+//	values:		the map being built
+//	node:		the value of the merge key, a map or a list of maps
+//	parents:	the nodes being converted
+func yamlMerge(values map[string]interface{}, node *yaml.Node, parents map[*yaml.Node]bool) error {
+	sources := []*yaml.Node{node}
+	if node.Kind == yaml.SequenceNode {
+		sources = node.Content
+	}
+	for _, source := range sources {
+		value, err := yamlValue(source, parents)
+		if err != nil {
+			return err
+		}
+		merged, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("line %d: a merge key must reference a map", source.Line)
+		}
+		for key, element := range merged {
+			if _, found := values[key]; !found {
+				values[key] = element
+			}
+		}
+	}
+	return nil
+}
+
+// Applies the values from the configuration file to the flags; sections are flattened into
+// the flag names, i.e. etcd: { cert: ... } is the etcd-cert flag
+//	flags:		the command line flags
+//	explicit:	the flags given on the command line
+//	values:		the values from the configuration file
+func applyValues(flags *flag.FlagSet, explicit map[string]bool, values map[string]interface{}) error {
+	// step: sort the names so any errors are consistent
+	names := make([]string, 0)
+	for name, _ := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := values[name]
+		flag_name := strings.Replace(strings.ToLower(name), "_", "-", -1)
+		if alias, found := file_aliases[flag_name]; found {
+			flag_name = alias
+		}
+		f := flags.Lookup(flag_name)
+		if f == nil {
+			// step: a section which isn't a flag is flattened into the names
+			section, ok := value.(map[string]interface{})
+			if !ok {
+				return errors.New("the option: " + name + " is not recognized")
+			}
+			flattened := make(map[string]interface{}, 0)
+			for key, element := range section {
+				flattened[name+"-"+key] = element
+			}
+			if err := applyValues(flags, explicit, flattened); err != nil {
+				return err
+			}
+			continue
+		}
+		if explicit[f.Name] {
+			continue
+		}
+		if err := setFlag(f, value); err != nil {
+			return fmt.Errorf("the option: %s is invalid, %s", name, err)
+		}
+	}
+	return nil
+}
+
+// Sets the value of a flag from a value in the configuration file; lists set the flag
+// multiple times and maps set it with KEY=VALUE
+//	f:			the flag to set
+//	value:		the value from the configuration file
+func setFlag(f *flag.Flag, value interface{}) error {
+	switch value := value.(type) {
+	case []interface{}:
+		for _, element := range value {
+			if err := setFlag(f, element); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		keys := make([]string, 0)
+		for key, _ := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			element, err := scalar(value[key])
+			if err != nil {
+				return err
+			}
+			if err := f.Value.Set(key + "=" + element); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	element, err := scalar(value)
+	if err != nil {
+		return err
+	}
+	return f.Value.Set(element)
+}
+
+// Converts a scalar from the configuration file into the string form of a flag
+//	value:		the value from the file
+func scalar(value interface{}) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	case nil:
+		return "", nil
+	}
+	return "", fmt.Errorf("the value: %v must be a string, number or boolean", value)
+}
+
+// Applies the environment overrides to the flags, i.e. CONFIG_HOOK_AGENT_STORE for -store; the
+// variables are set with CONFIG_HOOK_AGENT_VARIABLE_<NAME>=<VALUE>
+//	flags:			the command line flags
+//	explicit:		the flags given on the command line
+//	environment:	the environment of the agent
+func applyEnvironment(flags *flag.FlagSet, explicit map[string]bool, environment map[string]string) error {
+	var err error
+	flags.VisitAll(func(f *flag.Flag) {
+		if err != nil || explicit[f.Name] {
+			return
+		}
+		if value, found := environment[envName(f.Name)]; found {
+			if e := f.Value.Set(value); e != nil {
+				err = fmt.Errorf("the environment variable: %s is invalid, %s", envName(f.Name), e)
+			}
+			return
+		}
+		// step: check for any KEY=VALUE flags set per name
+		if _, ok := f.Value.(Variables); !ok {
+			return
+		}
+		prefix := envName(f.Name) + "_"
+		names := make([]string, 0)
+		for name, _ := range environment {
+			if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if e := f.Value.Set(strings.TrimPrefix(name, prefix) + "=" + environment[name]); e != nil {
+				err = fmt.Errorf("the environment variable: %s is invalid, %s", name, e)
+				return
+			}
+		}
+	})
+	return err
+}
+
+// Converts the name of a flag to the environment variable, i.e. etcd-cert is CONFIG_HOOK_AGENT_ETCD_CERT
+//	name:		the name of the flag
+func envName(name string) string {
+	return ENV_PREFIX + strings.ToUpper(strings.Replace(name, "-", "_", -1))
+}
+
+// Parses the environment into a map
+//	environ:	a list of KEY=VALUE
+func parseEnviron(environ []string) map[string]string {
+	environment := make(map[string]string, 0)
+	for _, kv := range environ {
+		elements := strings.SplitN(kv, "=", 2)
+		if len(elements) == 2 {
+			environment[elements[0]] = elements[1]
+		}
+	}
+	return environment
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const test_yaml = `
+# the agent configuration
+store: consul://127.0.0.1:8500
+interval: 30s
+cleanup: "delete"   # remove the keys
+variables:
+  ENVIRONMENT: prod
+  REGION: 'eu-west'
+consul:
+  token: secret
+`
+
+func newTestFlags() (*flag.FlagSet, *ConfigHookOptions) {
+	options := new(ConfigHookOptions)
+	options.Variables = make(Variables, 0)
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.StringVar(&options.Store_URL, "store", DEFAULT_STORE_URL, "")
+	flags.StringVar(&options.Cleanup, "cleanup", DEFAULT_CLEANUP, "")
+	flags.StringVar(&options.Config_File, "config", "", "")
+	flags.DurationVar(&options.Interval, "interval", 0, "")
+	flags.Var(options.Variables, "variable", "")
+	flags.StringVar(&options.Consul.Token, "consul-token", "", "")
+	return flags, options
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	directory, err := ioutil.TempDir("", "config")
+	assert.Nil(t, err)
+	filename := filepath.Join(directory, name)
+	assert.Nil(t, ioutil.WriteFile(filename, []byte(content), 0644))
+	return filename
+}
+
+func TestLoadYAML(t *testing.T) {
+	filename := writeTestFile(t, "agent.yaml", test_yaml)
+	defer os.RemoveAll(filepath.Dir(filename))
+	flags, options := newTestFlags()
+	assert.Nil(t, flags.Parse([]string{"-config", filename}))
+	assert.Nil(t, Load(flags, nil))
+	assert.Equal(t, "consul://127.0.0.1:8500", options.Store_URL)
+	assert.Equal(t, 30*time.Second, options.Interval)
+	assert.Equal(t, "delete", options.Cleanup)
+	assert.Equal(t, "secret", options.Consul.Token)
+	assert.Equal(t, Variables{"ENVIRONMENT": "prod", "REGION": "eu-west"}, options.Variables)
+}
+
+func TestLoadJSON(t *testing.T) {
+	filename := writeTestFile(t, "agent.json", `{"store": "memory://", "variable": ["ENVIRONMENT=dev"], "consul": {"token": "secret"}}`)
+	defer os.RemoveAll(filepath.Dir(filename))
+	flags, options := newTestFlags()
+	assert.Nil(t, flags.Parse([]string{"-config", filename}))
+	assert.Nil(t, Load(flags, nil))
+	assert.Equal(t, "memory://", options.Store_URL)
+	assert.Equal(t, "secret", options.Consul.Token)
+	assert.Equal(t, "dev", options.Variables["ENVIRONMENT"])
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	filename := writeTestFile(t, "agent.yaml", test_yaml)
+	defer os.RemoveAll(filepath.Dir(filename))
+	flags, options := newTestFlags()
+	assert.Nil(t, flags.Parse([]string{"-cleanup", "keep"}))
+	assert.Nil(t, Load(flags, []string{
+		"CONFIG_HOOK_AGENT_CONFIG=" + filename,
+		"CONFIG_HOOK_AGENT_STORE=etcd://10.0.0.1:4001",
+		"CONFIG_HOOK_AGENT_CLEANUP=remove",
+		"CONFIG_HOOK_AGENT_VARIABLE_ENVIRONMENT=dev",
+		"CONFIG_HOOK_INTERVAL=1m",
+	}))
+	assert.Equal(t, "etcd://10.0.0.1:4001", options.Store_URL)
+	assert.Equal(t, "keep", options.Cleanup)
+	assert.Equal(t, 30*time.Second, options.Interval)
+	assert.Equal(t, "dev", options.Variables["ENVIRONMENT"])
+	assert.Equal(t, "eu-west", options.Variables["REGION"])
+}
+
+func TestLoadErrors(t *testing.T) {
+	for content, name := range map[string]string{
+		"unknown: value\n":            "agent.yaml",
+		"interval: forever\n":         "agent.yaml",
+		"store:\n  - a\n - b\n":       "agent.yaml",
+		"consul:\n  unknown: value\n": "agent.yaml",
+		`{"store": `:                  "agent.json",
+	} {
+		filename := writeTestFile(t, name, content)
+		flags, _ := newTestFlags()
+		assert.Nil(t, flags.Parse([]string{"-config", filename}))
+		assert.Error(t, Load(flags, nil), "expected an error for: %s", content)
+		os.RemoveAll(filepath.Dir(filename))
+	}
+	flags, _ := newTestFlags()
+	assert.Nil(t, flags.Parse([]string{"-config", "/does/not/exist.yaml"}))
+	assert.Error(t, Load(flags, nil))
+}
+
+func TestParseYAML(t *testing.T) {
+	values, err := ParseYAML("a: 1\nb:\n  c: \"x # y\"\n  d:\n    - one\n    - 'two'\ne: true # comment\n")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"a": "1",
+		"b": map[string]interface{}{
+			"c": "x # y",
+			"d": []interface{}{"one", "two"},
+		},
+		"e": true,
+	}, values)
+	values, err = ParseYAML("servers:\n- name: a\n  port: 80\n-   name: b\n    tags:\n      - web\nlimits:\n  - 1\n")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"servers": []interface{}{
+			map[string]interface{}{"name": "a", "port": "80"},
+			map[string]interface{}{"name": "b", "tags": []interface{}{"web"}},
+		},
+		"limits": []interface{}{"1"},
+	}, values)
+	_, err = ParseYAML("a: 1\na: 2\n")
+	assert.Error(t, err)
+	values, err = ParseYAML("defaults: &defaults\n  interval: 30s\n  store: etcd://127.0.0.1:4001\nagent:\n  <<: *defaults\n  store: consul://127.0.0.1:8500\n  labels: [a, 'b']\n  motd: |\n    one\n    two\n")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"interval": "30s",
+		"store":    "consul://127.0.0.1:8500",
+		"labels":   []interface{}{"a", "b"},
+		"motd":     "one\ntwo\n",
+	}, values["agent"])
+	_, err = ParseYAML("a: [1, 2\n")
+	assert.Error(t, err)
+	_, err = ParseYAML("a:1\n")
+	assert.Error(t, err)
+	_, err = ParseYAML("- a\n- b\n")
+	assert.Error(t, err)
+	_, err = ParseYAML("a: &a\n  b: *a\n")
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	options := ConfigHookOptions{
+		Docker_Socket:  DEFAULT_DOCKER_SOCKET,
+		Runtime_Prefix: DEFAULT_RUNTIME_PREFIX,
+		Label_Prefix:   DEFAULT_LABEL_PREFIX,
+		Store_URL:      DEFAULT_STORE_URL,
+		Cleanup:        DEFAULT_CLEANUP,
+		Conflict:       DEFAULT_CONFLICT,
+	}
+	assert.Nil(t, options.Validate())
+	options.Cleanup = " Remove "
+	options.Conflict = "FIRST"
+	assert.Nil(t, options.Validate())
+	options.Store_URL = "127.0.0.1"
+	options.Listen = "8080"
+	options.Etcd.Cert_File = "/does/not/exist"
+	options.TTL = -time.Second
+	options.Max_Size = -1
+	options.Cleanup = "destroy"
+	options.Conflict = "never"
+	err := options.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "store url")
+	assert.Contains(t, err.Error(), "listen address")
+	assert.Contains(t, err.Error(), "etcd certificate and key")
+	assert.Contains(t, err.Error(), "not accessible")
+	assert.Contains(t, err.Error(), "ttl")
+	assert.Contains(t, err.Error(), "maximum size")
+	assert.Contains(t, err.Error(), "cleanup policy: destroy")
+	assert.Contains(t, err.Error(), "conflict policy: never")
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gambol99/config-hook/metrics"
+
+	"github.com/golang/glog"
+)
+
+const (
+	API_CONTAINERS = "/containers"
+	API_METRICS    = "/metrics"
+	API_HEALTH     = "/health"
+)
+
+// The status of the hooks for a container
+type ContainerStatus struct {
+	// the id of the container
+	ID string `json:"id"`
+	// the hook files for the container
+	Files []*HookFile `json:"files"`
+	// the hook keys for the container
+	Keys []*HookKeys `json:"keys"`
+	// the hooks which were rejected
+	Rejected []*HookError `json:"rejected"`
+}
+
+// Starts the http server providing the read only status api
+//	listen:		the interface and port to listen on, i.e. :8080
+func (r *ConfigHookService) startAPI(listen string) error {
+	listener, err := net.Listen("tcp", listen)
+	if err != nil {
+		return err
+	}
+	r.listener = listener
+	mux := http.NewServeMux()
+	mux.HandleFunc(API_CONTAINERS, r.apiContainers)
+	mux.HandleFunc(API_CONTAINERS+"/", r.apiContainer)
+	mux.Handle(API_METRICS, metrics.Handler())
+	mux.HandleFunc(API_HEALTH, r.apiHealth)
+	glog.Infof("Starting the status api on: %s", listen)
+	go func() {
+		if err := http.Serve(listener, mux); err != nil {
+			glog.V(3).Infof("The status api has stopped, error: %s", err)
+		}
+	}()
+	return nil
+}
+
+// Handles the listing of all the managed containers and their hooks
+func (r *ConfigHookService) apiContainers(writer http.ResponseWriter, request *http.Request) {
+	if !r.apiValidRequest(writer, request) {
+		return
+	}
+	r.RLock()
+	defer r.RUnlock()
+	list := make([]*ContainerStatus, 0)
+	for containerId, hooks := range r.hooks {
+		list = append(list, r.containerStatus(containerId, hooks))
+	}
+	sort.Sort(containerStatusByID(list))
+	r.apiResponse(writer, http.StatusOK, list)
+}
+
+// Handles the retrieval of the hooks for a single container, the id can be the short form
+func (r *ConfigHookService) apiContainer(writer http.ResponseWriter, request *http.Request) {
+	if !r.apiValidRequest(writer, request) {
+		return
+	}
+	id := strings.TrimPrefix(request.URL.Path, API_CONTAINERS+"/")
+	r.RLock()
+	defer r.RUnlock()
+	if id != "" {
+		for containerId, hooks := range r.hooks {
+			if strings.HasPrefix(containerId, id) {
+				r.apiResponse(writer, http.StatusOK, r.containerStatus(containerId, hooks))
+				return
+			}
+		}
+	}
+	r.apiResponse(writer, http.StatusNotFound, map[string]string{"error": "the container: " + id + " is not being managed"})
+}
+
+// Handles the health of the agent, a 503 is returned while the docker event stream is down
+func (r *ConfigHookService) apiHealth(writer http.ResponseWriter, request *http.Request) {
+	if !r.apiValidRequest(writer, request) {
+		return
+	}
+	health := r.docker.Health()
+	code := http.StatusOK
+	if !health.Connected {
+		code = http.StatusServiceUnavailable
+	}
+	r.apiResponse(writer, code, map[string]interface{}{"docker": health})
+}
+
+func (r *ConfigHookService) apiValidRequest(writer http.ResponseWriter, request *http.Request) bool {
+	if request.Method != "GET" {
+		r.apiResponse(writer, http.StatusMethodNotAllowed, map[string]string{"error": "the api is read only"})
+		return false
+	}
+	return true
+}
+
+func (r *ConfigHookService) apiResponse(writer http.ResponseWriter, code int, data interface{}) {
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		glog.Errorf("Failed to encode the api response, error: %s", err)
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(code)
+	writer.Write(content)
+}
+
+// Generates the status for a container, must be called with the lock held
+func (r *ConfigHookService) containerStatus(containerId string, hooks *Hooks) *ContainerStatus {
+	status := &ContainerStatus{
+		ID:       containerId,
+		Files:    make([]*HookFile, 0),
+		Keys:     make([]*HookKeys, 0),
+		Rejected: make([]*HookError, 0),
+	}
+	for _, file := range hooks.files {
+		status.Files = append(status.Files, file)
+	}
+	for _, keys := range hooks.keys {
+		status.Keys = append(status.Keys, keys)
+	}
+	status.Rejected = append(status.Rejected, hooks.rejected...)
+	return status
+}
+
+type containerStatusByID []*ContainerStatus
+
+func (r containerStatusByID) Len() int           { return len(r) }
+func (r containerStatusByID) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r containerStatusByID) Less(i, j int) bool { return r[i].ID < r[j].ID }
@@ -0,0 +1,97 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIContainers(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg;/usr/bin/restart",
+		"CONFIG_HOOK_FILE_BAD":     "/etc/bad.cfg",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/containers", nil)
+	service.apiContainers(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	list := make([]map[string]interface{}, 0)
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &list))
+	assert.Equal(t, 1, len(list))
+	assert.Equal(t, TEST_CONTAINER, list[0]["id"])
+	assert.Equal(t, 1, len(list[0]["files"].([]interface{})))
+	assert.Equal(t, 1, len(list[0]["rejected"].([]interface{})))
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/containers/"+TEST_CONTAINER[:12], nil)
+	service.apiContainer(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	status := make(map[string]interface{}, 0)
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+	file := status["files"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "/env/haproxy.cfg", file["key"])
+	assert.Equal(t, "", file["last_error"])
+	assert.Equal(t, "/usr/bin/restart", file["exec"].(map[string]interface{})["command"])
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/containers/missing", nil)
+	service.apiContainer(recorder, request)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/containers", nil)
+	service.apiContainers(recorder, request)
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestAPIMetrics(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	assert.Nil(t, service.startAPI("127.0.0.1:0"))
+	defer service.listener.Close()
+	response, err := http.Get("http://" + service.listener.Addr().String() + API_METRICS)
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	content, _ := ioutil.ReadAll(response.Body)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, string(content), "config_hook_containers_processed_total")
+	assert.Contains(t, string(content), `config_hook_hooks_parsed_total{type="FILE"}`)
+	assert.Contains(t, string(content), "config_hook_store_writes_total")
+}
+
+func TestAPIHealth(t *testing.T) {
+	service, _ := newTestService(t)
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/health", nil)
+	service.apiHealth(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	health := make(map[string]map[string]interface{}, 0)
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &health))
+	assert.Equal(t, true, health["docker"]["connected"])
+}
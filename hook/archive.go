@@ -0,0 +1,239 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gambol99/config-hook/config"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// the maximum number of symbolic links followed when reading a file
+	DOCKER_MAX_LINKS = 10
+)
+
+// The error returned when reading a file from a container which is a directory
+var DirectoryErr = errors.New("the path is a directory")
+
+// The content of a path copied from a container
+type containerArchive struct {
+	// the header of the path which was copied
+	root *tar.Header
+	// the content of the regular files, keyed by the path relative to the root
+	files map[string]string
+	// the targets of the symbolic links, keyed by the path relative to the root
+	links map[string]string
+	// the total size of the content read
+	size int64
+}
+
+// Reads the tar archive of a path copied from a container; the first entry is the path itself
+// and, for a directory, the rest are the entries beneath it. An error is returned if the size
+// of the content exceeds the maximum
+//	reader:		the tar archive
+//	max:		the maximum size of the content, zero for no limit
+//	tree:		read the entries beneath a directory, otherwise we stop at the directory
+func readArchive(reader io.Reader, max int64, tree bool) (*containerArchive, error) {
+	archive := &containerArchive{
+		files: make(map[string]string, 0),
+		links: make(map[string]string, 0),
+	}
+	entries := tar.NewReader(reader)
+	for {
+		header, err := entries.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if archive.root == nil {
+			archive.root = header
+			if header.Typeflag == tar.TypeDir && !tree {
+				return archive, nil
+			}
+		}
+		name, valid := archiveName(archive.root.Name, header.Name)
+		if !valid {
+			glog.Warningf("Skipping the entry: %s in the archive, it is outside of: %s", header.Name, archive.root.Name)
+			continue
+		}
+		switch header.Typeflag {
+		case tar.TypeReg, tar.TypeRegA:
+			if err := archive.grow(header.Size, max); err != nil {
+				return nil, err
+			}
+			content, err := ioutil.ReadAll(entries)
+			if err != nil {
+				return nil, err
+			}
+			archive.files[name] = string(content)
+		case tar.TypeLink:
+			// a hard link refers to an entry earlier in the archive
+			target, _ := archiveName(archive.root.Name, header.Linkname)
+			if content, found := archive.files[target]; found {
+				if err := archive.grow(int64(len(content)), max); err != nil {
+					return nil, err
+				}
+				archive.files[name] = content
+			}
+		case tar.TypeSymlink:
+			archive.links[name] = header.Linkname
+		}
+	}
+	if archive.root == nil {
+		return nil, errors.New("the archive from docker is empty")
+	}
+	return archive, nil
+}
+
+// Adds to the size of the content read, returning an error if over the maximum
+//	size:		the size of the content being added
+//	max:		the maximum size of the content, zero for no limit
+func (r *containerArchive) grow(size, max int64) error {
+	r.size += size
+	if max > 0 && r.size > max {
+		return fmt.Errorf("the content exceeds the maximum size of %d bytes", max)
+	}
+	return nil
+}
+
+// Retrieves the path of an entry relative to the root of the archive; false if the entry
+// is not beneath the root
+//	root:		the name of the root entry
+//	name:		the name of the entry
+func archiveName(root, name string) (string, bool) {
+	root = strings.TrimSuffix(root, "/")
+	name = strings.TrimSuffix(name, "/")
+	if name == root {
+		return "", true
+	}
+	if !strings.HasPrefix(name, root+"/") {
+		return "", false
+	}
+	name = path.Clean(strings.TrimPrefix(name, root+"/"))
+	if name == ".." || strings.HasPrefix(name, "../") {
+		return "", false
+	}
+	return name, true
+}
+
+// Resolves the target of a symbolic link inside the container
+//	filename:	the path of the link
+//	link:		the target of the link, absolute or relative to the link
+func resolveLink(filename, link string) string {
+	if path.IsAbs(link) {
+		return path.Clean(link)
+	}
+	return path.Join(path.Dir(filename), link)
+}
+
+// Copies a path from the container, reading the tar archive as it's streamed so the maximum
+// size is enforced without holding the whole archive in memory
+//	containerID:	the container to copy from
+//	resource:		the path inside the container
+//	tree:			read the entries beneath a directory
+func (r *DockerService) copyArchive(containerID, resource string, tree bool) (*containerArchive, error) {
+	body, err := json.Marshal(map[string]string{"Resource": resource})
+	if err != nil {
+		return nil, err
+	}
+	uri := "/containers/" + containerID + "/copy"
+	response, err := r.http.Post("http://docker"+uri, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response from docker, uri: %s, code: %d", uri, response.StatusCode)
+	}
+	return readArchive(response.Body, config.Options.Max_Size, tree)
+}
+
+// Copies a path from the container, following the path if it's a symbolic link
+//	containerID:	the container to copy from
+//	filename:		the path inside the container
+//	tree:			read the entries beneath a directory
+func (r *DockerService) resolveArchive(containerID, filename string, tree bool) (*containerArchive, string, error) {
+	for links := 0; links <= DOCKER_MAX_LINKS; links++ {
+		archive, err := r.copyArchive(containerID, filename, tree)
+		if err != nil {
+			return nil, filename, err
+		}
+		if archive.root.Typeflag != tar.TypeSymlink {
+			return archive, filename, nil
+		}
+		glog.V(5).Infof("The path: %s in container: %s is a link to: %s", filename, containerID[:12], archive.root.Linkname)
+		filename = resolveLink(filename, archive.root.Linkname)
+	}
+	return nil, filename, fmt.Errorf("too many levels of symbolic links, path: %s", filename)
+}
+
+func (r *DockerService) GetFile(containerID, filename string) (string, error) {
+	archive, resolved, err := r.resolveArchive(containerID, filename, false)
+	if err != nil {
+		glog.Errorf("Failed to copy the file: %s from the container: %s, error: %s", filename, containerID[:12], err)
+		return "", err
+	}
+	if archive.root.Typeflag == tar.TypeDir {
+		return "", DirectoryErr
+	}
+	content, found := archive.files[""]
+	if !found {
+		return "", fmt.Errorf("the path: %s in the container is not a regular file", resolved)
+	}
+	return content, nil
+}
+
+func (r *DockerService) GetDirectory(containerID, dirname string) (map[string]string, error) {
+	archive, resolved, err := r.resolveArchive(containerID, dirname, true)
+	if err != nil {
+		glog.Errorf("Failed to copy the directory: %s from the container: %s, error: %s", dirname, containerID[:12], err)
+		return nil, err
+	}
+	if archive.root.Typeflag != tar.TypeDir {
+		return nil, fmt.Errorf("the path: %s in the container is not a directory", resolved)
+	}
+	// step: follow the links beneath the directory; we don't descend into linked directories
+	for name, link := range archive.links {
+		target := resolveLink(path.Join(resolved, name), link)
+		content, err := r.GetFile(containerID, target)
+		if err == DirectoryErr {
+			glog.V(3).Infof("Skipping the link: %s in directory: %s, container: %s, it is a directory", name, resolved, containerID[:12])
+			continue
+		}
+		if err != nil {
+			glog.Warningf("Skipping the link: %s in directory: %s, container: %s, error: %s", name, resolved, containerID[:12], err)
+			continue
+		}
+		if err := archive.grow(int64(len(content)), config.Options.Max_Size); err != nil {
+			return nil, err
+		}
+		archive.files[name] = content
+	}
+	delete(archive.files, "")
+	return archive.files, nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gambol99/config-hook/config"
+	"github.com/stretchr/testify/assert"
+)
+
+/* an entry in a test archive */
+type testEntry struct {
+	name, content, link string
+	kind                byte
+}
+
+func newTestArchive(t *testing.T, entries ...testEntry) []byte {
+	var buffer bytes.Buffer
+	writer := tar.NewWriter(&buffer)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Typeflag: entry.kind, Linkname: entry.link, Mode: 0644}
+		if entry.kind == tar.TypeReg {
+			header.Size = int64(len(entry.content))
+		}
+		assert.Nil(t, writer.WriteHeader(header))
+		if entry.kind == tar.TypeReg {
+			_, err := writer.Write([]byte(entry.content))
+			assert.Nil(t, err)
+		}
+	}
+	assert.Nil(t, writer.Close())
+	return buffer.Bytes()
+}
+
+func TestReadArchiveFile(t *testing.T) {
+	content := newTestArchive(t, testEntry{name: "haproxy.cfg", content: "haproxy config", kind: tar.TypeReg})
+	archive, err := readArchive(bytes.NewReader(content), 0, false)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(tar.TypeReg), archive.root.Typeflag)
+	assert.Equal(t, map[string]string{"": "haproxy config"}, archive.files)
+
+	// step: the content cannot exceed the maximum size
+	_, err = readArchive(bytes.NewReader(content), 4, false)
+	assert.NotNil(t, err)
+
+	_, err = readArchive(bytes.NewReader(nil), 0, false)
+	assert.NotNil(t, err)
+}
+
+func TestReadArchiveDirectory(t *testing.T) {
+	content := newTestArchive(t,
+		testEntry{name: "certs/", kind: tar.TypeDir},
+		testEntry{name: "certs/ca.pem", content: "ca", kind: tar.TypeReg},
+		testEntry{name: "certs/hosts/", kind: tar.TypeDir},
+		testEntry{name: "certs/hosts/web.pem", content: "web", kind: tar.TypeReg},
+		testEntry{name: "certs/hosts/www.pem", link: "certs/hosts/web.pem", kind: tar.TypeLink},
+		testEntry{name: "certs/current.pem", link: "hosts/web.pem", kind: tar.TypeSymlink},
+		testEntry{name: "other/escape.pem", content: "escape", kind: tar.TypeReg},
+	)
+	archive, err := readArchive(bytes.NewReader(content), 0, true)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"ca.pem": "ca", "hosts/web.pem": "web", "hosts/www.pem": "web"}, archive.files)
+	assert.Equal(t, map[string]string{"current.pem": "hosts/web.pem"}, archive.links)
+	assert.Equal(t, int64(8), archive.size)
+
+	// step: without the tree we stop at the directory
+	archive, err = readArchive(bytes.NewReader(content), 0, false)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(tar.TypeDir), archive.root.Typeflag)
+	assert.Empty(t, archive.files)
+}
+
+func TestArchiveName(t *testing.T) {
+	for root, expected := range map[[2]string]string{
+		{"certs/", "certs/"}:           "",
+		{"certs", "certs/ca.pem"}:      "ca.pem",
+		{"certs/", "certs/a/../b.pem"}: "b.pem",
+	} {
+		name, valid := archiveName(root[0], root[1])
+		assert.True(t, valid)
+		assert.Equal(t, expected, name)
+	}
+	for _, name := range []string{"other/ca.pem", "certs/../../etc/passwd", "certsx/ca.pem"} {
+		_, valid := archiveName("certs", name)
+		assert.False(t, valid, "the entry: %s should be invalid", name)
+	}
+}
+
+func TestResolveLink(t *testing.T) {
+	assert.Equal(t, "/etc/ssl/cert.pem", resolveLink("/etc/cert.pem", "ssl/cert.pem"))
+	assert.Equal(t, "/etc/cert.pem", resolveLink("/etc/ssl/cert.pem", "../cert.pem"))
+	assert.Equal(t, "/opt/cert.pem", resolveLink("/etc/cert.pem", "/opt//cert.pem"))
+}
+
+/* a fake docker copy api serving the archives of the paths in the container */
+type fakeCopy map[string][]byte
+
+func (r fakeCopy) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	var options struct{ Resource string }
+	if err := json.NewDecoder(request.Body).Decode(&options); err != nil {
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	content, found := r[options.Resource]
+	if !found {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+	writer.Write(content)
+}
+
+func TestDockerGetFile(t *testing.T) {
+	directory, err := ioutil.TempDir("", "docker")
+	assert.Nil(t, err)
+	defer os.RemoveAll(directory)
+	socket := filepath.Join(directory, "docker.sock")
+	listener, err := net.Listen("unix", socket)
+	assert.Nil(t, err)
+
+	archives := fakeCopy{
+		"/etc/haproxy.cfg":         newTestArchive(t, testEntry{name: "haproxy.cfg", link: "haproxy/haproxy.cfg", kind: tar.TypeSymlink}),
+		"/etc/haproxy/haproxy.cfg": newTestArchive(t, testEntry{name: "haproxy.cfg", content: "haproxy config", kind: tar.TypeReg}),
+		"/etc/loop":                newTestArchive(t, testEntry{name: "loop", link: "/etc/loop", kind: tar.TypeSymlink}),
+		"/etc/certs": newTestArchive(t,
+			testEntry{name: "certs", kind: tar.TypeDir},
+			testEntry{name: "certs/ca.pem", content: "ca", kind: tar.TypeReg},
+			testEntry{name: "certs/haproxy.cfg", link: "../haproxy.cfg", kind: tar.TypeSymlink},
+			testEntry{name: "certs/missing.pem", link: "/missing.pem", kind: tar.TypeSymlink},
+		),
+	}
+	server := httptest.NewUnstartedServer(archives)
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+	service := newDockerService(socket)
+
+	// step: the links to the file are followed
+	content, err := service.GetFile(TEST_CONTAINER, "/etc/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", content)
+	_, err = service.GetFile(TEST_CONTAINER, "/etc/loop")
+	assert.NotNil(t, err)
+	_, err = service.GetFile(TEST_CONTAINER, "/etc/certs")
+	assert.Equal(t, DirectoryErr, err)
+
+	// step: the directory includes the files it links to, skipping those missing
+	files, err := service.GetDirectory(TEST_CONTAINER, "/etc/certs")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"ca.pem": "ca", "haproxy.cfg": "haproxy config"}, files)
+	_, err = service.GetDirectory(TEST_CONTAINER, "/etc/haproxy.cfg")
+	assert.NotNil(t, err)
+
+	// step: the maximum size is enforced
+	defer func(size int64) { config.Options.Max_Size = size }(config.Options.Max_Size)
+	config.Options.Max_Size = 4
+	_, err = service.GetFile(TEST_CONTAINER, "/etc/haproxy.cfg")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/gambol99/config-hook/config"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// leave the keys in the store
+	CLEANUP_KEEP = "keep"
+	// delete the key from the store
+	CLEANUP_DELETE = "delete"
+	// recursively remove the path from the store
+	CLEANUP_REMOVE = "remove"
+)
+
+// Parses and validates a cleanup policy
+//	policy:		the name of the policy, i.e. keep, delete or remove
+func parseCleanupPolicy(policy string) (string, error) {
+	policy = strings.ToLower(strings.TrimSpace(policy))
+	switch policy {
+	case CLEANUP_KEEP, CLEANUP_DELETE, CLEANUP_REMOVE:
+		return policy, nil
+	}
+	return "", errors.New("the cleanup policy: " + policy + " is invalid, must be keep, delete or remove")
+}
+
+// Retrieves the cleanup policy for a hook, falling back to the agent policy if not set
+//	policy:		the cleanup policy of the hook
+func cleanupPolicy(policy string) string {
+	if policy == "" {
+		return config.Options.Cleanup
+	}
+	return policy
+}
+
+// Applies the cleanup policies to the hooks of a container which has been destroyed
+//	containerId:	the container which has been destroyed
+//	hooks:			the hooks for the container
+func (r *ConfigHookService) cleanupHooks(containerId string, hooks *Hooks) {
+	// step: a failed republish doesn't undo what was published before it, so we cleanup
+	// whatever the hook has published, regardless of the last error
+	for _, file := range hooks.files {
+		r.cleanupHook(containerId, file.ID, cleanupPolicy(file.Cleanup), file.Key, publishedContent(file.PublishedKeys()))
+	}
+	for _, keys := range hooks.keys {
+		r.cleanupHook(containerId, keys.ID, cleanupPolicy(keys.Cleanup), keys.Key, keys.Keys)
+	}
+}
+
+// Applies the cleanup policy to the keys published by a hook; with the remove policy the base
+// key of the hook is recursively removed, falling back to deleting the keys individually if the
+// base cannot be removed
+//	containerId:	the container which has been destroyed
+//	id:				the name of the hook
+//	policy:			the cleanup policy to apply
+//	base:			the base key of the hook, empty if none
+//	published:		the keys published by the hook and the checksum of their content
+func (r *ConfigHookService) cleanupHook(containerId, id, policy, base string, published map[string]string) {
+	if policy == CLEANUP_REMOVE && base != "" {
+		removed, err := r.removeBase(containerId, base, published)
+		if err != nil {
+			glog.Errorf("Failed to remove the path: %s for hook: %s, container: %s, error: %s", base, id, containerId[:12], err)
+		}
+		if removed {
+			return
+		}
+		policy = CLEANUP_DELETE
+	}
+	for key, checksum := range published {
+		if err := r.cleanupKey(containerId, policy, key, checksum); err != nil {
+			glog.Errorf("Failed to cleanup the key: %s for hook: %s, container: %s, error: %s",
+				key, id, containerId[:12], err)
+		}
+	}
+}
+
+// Recursively removes the base key of a hook, including anything beneath it we did not publish;
+// every key published by the container must still be owned by it and no other hook we manage
+// can be publishing beneath the base, otherwise the base is left alone
+//	containerId:	the container which has been destroyed
+//	base:			the base key of the hook
+//	published:		the keys published by the hook and the checksum of their content
+func (r *ConfigHookService) removeBase(containerId, base string, published map[string]string) (bool, error) {
+	if path.Clean("/"+base) == "/" || len(published) == 0 {
+		return false, nil
+	}
+	for key, checksum := range published {
+		owner, err := r.isOwner(key, checksum)
+		if err != nil || !owner {
+			return false, err
+		}
+		other, err := r.isOwnedByOther(containerId, key)
+		if err != nil || other {
+			return false, err
+		}
+	}
+	if r.isPublishedBeneath(base) {
+		glog.V(3).Infof("Another container is publishing beneath: %s, skipping the removal", base)
+		return false, nil
+	}
+	// step: if the base can't be found we fall back to deleting the keys individually
+	found, err := r.store.Exists(base)
+	if err != nil || !found {
+		return false, err
+	}
+	glog.V(3).Infof("Cleaning up the path: %s from container: %s, policy: %s", base, containerId[:12], CLEANUP_REMOVE)
+	if err := r.store.RemovePath(base); err != nil {
+		return false, err
+	}
+	// step: remove any metadata recorded for the keys
+	for key, _ := range published {
+		if err := r.removeMetadata(key); err != nil {
+			glog.Errorf("Failed to remove the metadata of key: %s, error: %s", key, err)
+		}
+	}
+	return true, nil
+}
+
+// Filters the keys of a hook down to those we have published content to; a key without a
+// checksum was never published by the hook, i.e. a one time hook which found the key existing
+//	keys:		a map of the keys and the checksum of the content published
+func publishedContent(keys map[string]string) map[string]string {
+	published := make(map[string]string, 0)
+	for key, checksum := range keys {
+		if checksum != "" {
+			published[key] = checksum
+		}
+	}
+	return published
+}
+
+// Checks if any of the hooks we are managing publish a key at or beneath the path
+//	base:		the path in the store
+func (r *ConfigHookService) isPublishedBeneath(base string) bool {
+	base = strings.Trim(base, "/")
+	beneath := func(key string) bool {
+		key = strings.Trim(key, "/")
+		return key == base || strings.HasPrefix(key, base+"/")
+	}
+	for _, hooks := range r.hooks {
+		for _, file := range hooks.files {
+			for key, _ := range file.PublishedKeys() {
+				if beneath(key) {
+					return true
+				}
+			}
+		}
+		for _, keys := range hooks.keys {
+			for key, _ := range keys.Keys {
+				if beneath(key) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Removes a key published by a container, so long as it's still owned by the container
+//	containerId:	the container which published the key
+//	policy:			the cleanup policy to apply
+//	key:			the key in the store
+//	checksum:		the checksum of the content published by the container
+func (r *ConfigHookService) cleanupKey(containerId, policy, key, checksum string) error {
+	if policy == CLEANUP_KEEP {
+		return nil
+	}
+	// step: check the key is still owned by the container
+	owner, err := r.isOwner(key, checksum)
+	if err != nil {
+		return err
+	}
+	if owner {
+		other, err := r.isOwnedByOther(containerId, key)
+		if err != nil {
+			return err
+		}
+		owner = !other
+	}
+	if !owner {
+		glog.V(3).Infof("The key: %s is no longer owned by container: %s, skipping cleanup", key, containerId[:12])
+		return nil
+	}
+	glog.V(3).Infof("Cleaning up the key: %s from container: %s, policy: %s", key, containerId[:12], policy)
+	switch policy {
+	case CLEANUP_DELETE:
+		err = r.store.Delete(key)
+	case CLEANUP_REMOVE:
+		err = r.store.RemovePath(key)
+	}
+	if err != nil {
+		return err
+	}
+	// step: remove any metadata recorded for the key
+	return r.removeMetadata(key)
+}
+
+// Checks the key in the store still holds the content published by us and is not being
+// used by another of the containers we are managing
+//	key:		the key in the store
+//	checksum:	the checksum of the content we published
+func (r *ConfigHookService) isOwner(key, checksum string) (bool, error) {
+	// step: is another container publishing to the key?
+	for _, hooks := range r.hooks {
+		for _, file := range hooks.files {
+			if file.HasKey(key) {
+				return false, nil
+			}
+		}
+		for _, keys := range hooks.keys {
+			for name, _ := range keys.Keys {
+				if isSameKey(name, key) {
+					return false, nil
+				}
+			}
+		}
+	}
+	// step: has the content been changed by someone else?
+	found, err := r.store.Exists(key)
+	if err != nil || !found {
+		return false, err
+	}
+	node, err := r.store.Get(key)
+	if err != nil {
+		return false, err
+	}
+	if node.IsDir() {
+		return false, nil
+	}
+	return getChecksum(node.Value) == checksum, nil
+}
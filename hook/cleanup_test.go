@@ -0,0 +1,115 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+
+	"github.com/gambol99/config-hook/config"
+	"github.com/gambol99/config-hook/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCleanupPolicy(t *testing.T) {
+	policy, err := parseCleanupPolicy(" Delete ")
+	assert.Nil(t, err)
+	assert.Equal(t, CLEANUP_DELETE, policy)
+	policy, err = parseCleanupPolicy("remove")
+	assert.Nil(t, err)
+	assert.Equal(t, CLEANUP_REMOVE, policy)
+	_, err = parseCleanupPolicy("destroy")
+	assert.NotNil(t, err)
+}
+
+func TestCleanupPolicy(t *testing.T) {
+	assert.Equal(t, config.Options.Cleanup, cleanupPolicy(""))
+	assert.Equal(t, CLEANUP_REMOVE, cleanupPolicy(CLEANUP_REMOVE))
+}
+
+func TestHookCleanupSet(t *testing.T) {
+	setHookPrefix("CONFIG_HOOK_")
+	c := NewHooksConfig()
+	_, name, element, err := c.ParseKey("CONFIG_HOOK_FILE_HAPROXY_CLEANUP")
+	assert.Nil(t, err)
+	assert.Equal(t, "HAPROXY", name)
+	assert.Equal(t, "CLEANUP", element)
+	file := c.Files(name)
+	assert.Nil(t, file.Set(element, "delete"))
+	assert.Equal(t, CLEANUP_DELETE, file.Cleanup)
+	assert.NotNil(t, file.Set(element, "bad"))
+}
+
+// a store without directories, i.e. a prefix which holds keys is not found
+type flatStore struct {
+	store.Store
+}
+
+func (r *flatStore) Exists(key string) (bool, error) {
+	node, err := r.Store.Get(key)
+	if err == store.KeyNotFoundErr || (err == nil && node.IsDir()) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func TestCleanupRemoveWithoutDirectories(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_CERTS":         "/etc/certs;/env/certs",
+		"CONFIG_HOOK_FILE_CERTS_CLEANUP": "remove",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/certs/ca.pem":        "ca",
+		"/etc/certs/hosts/web.pem": "web",
+	}
+	service.store = &flatStore{service.store}
+	service.processContainerCreation(TEST_CONTAINER)
+	assert.Nil(t, service.store.Set("/env/certs/hosts/old.pem", "old"))
+	service.processContainerDestruction(TEST_CONTAINER)
+
+	// step: the base can't be found, so we fall back to deleting the keys we published
+	for _, key := range []string{"/env/certs/ca.pem", "/env/certs/hosts/web.pem"} {
+		found, err := service.store.Exists(key)
+		assert.Nil(t, err)
+		assert.False(t, found, key)
+	}
+	found, err := service.store.Exists("/env/certs/hosts/old.pem")
+	assert.Nil(t, err)
+	assert.True(t, found)
+}
+
+func TestCleanupAfterFailedPublish(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":         "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_FILE_HAPROXY_CLEANUP": "delete",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	service.processContainerCreation(TEST_CONTAINER)
+	file := service.hooks[TEST_CONTAINER].files["HAPROXY"]
+	assert.True(t, file.IsPublished())
+
+	// step: the republish fails, but the content published before it is still ours
+	delete(docker.files[TEST_CONTAINER], "/etc/haproxy.cfg")
+	assert.NotNil(t, service.publishFile(TEST_CONTAINER, file))
+	assert.False(t, file.IsPublished())
+	service.processContainerDestruction(TEST_CONTAINER)
+
+	found, err := service.store.Exists("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.False(t, found)
+	found, err = service.store.Exists(ownerKey("/env/haproxy.cfg"))
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
@@ -14,12 +14,16 @@ limitations under the License.
 package hook
 
 import (
-	"bufio"
 	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net"
+	"net/http"
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gambol99/config-hook/config"
 
@@ -32,20 +36,31 @@ type DockerEvent chan string
 const (
 	DOCKER_START   = "start"
 	DOCKER_DIE     = "die"
+	DOCKER_STOP    = "stop"
 	DOCKER_CREATED = "created"
 	DOCKER_DESTROY = "destroy"
 )
 
 // The interface to docker
 type DockerStore interface {
-	// retrieve the contents of a file in a container
+	// retrieve the contents of a file in a container, following any links; DirectoryErr if a directory
 	GetFile(containerID, filename string) (string, error)
-	// Get a listing of containers
-	List() ([]string, error)
+	// retrieve the contents of every file beneath a directory, keyed by the path relative to the directory
+	GetDirectory(containerID, dirname string) (map[string]string, error)
+	// Get a listing of the running containers, or all the containers including those stopped
+	List(all bool) ([]string, error)
 	// watch for docker events
 	Watch(channel DockerEvent, event_type string)
 	// retrieve the environment variables for a container
 	Environment(containerID string) (map[string]string, error)
+	// retrieve the labels of the image and the container
+	Labels(containerID string) (map[string]string, map[string]string, error)
+	// retrieve the name of the image the container was created from
+	Image(containerID string) (string, error)
+	// execute a command inside the container, returning the exit code and output
+	Execute(containerID, command string) (int, string, error)
+	// retrieve the health of the docker event stream
+	Health() DockerHealth
 	// Close down the resources
 	Close()
 }
@@ -56,12 +71,20 @@ type DockerService struct {
 	sync.Once
 	// the docker client
 	client *dockerapi.Client
-	// the channel WE receive docker events on
-	updates chan *dockerapi.APIEvents
+	// the http client for the docker api calls not supported by the client
+	http *http.Client
 	// a slice of those listening to creation events
 	listeners map[string][]DockerEvent
 	// the shutdown channel
 	shutdown ShutdownChannel
+	// the health of the event stream
+	health DockerHealth
+	// the time of the last event received, used to replay events after a reconnect
+	last_seen int64
+	// the events received within the second of the last event, used to filter the replay
+	seen map[string]bool
+	// the initial and maximum wait between reconnects to the event stream
+	retry_min, retry_max time.Duration
 }
 
 func NewDockerStore() (DockerStore, error) {
@@ -73,9 +96,7 @@ func NewDockerStore() (DockerStore, error) {
 	} else if !valid {
 		return nil, errors.New("invalid docker socket, please check")
 	}
-	service := new(DockerService)
-	service.listeners = make(map[string][]DockerEvent, 0)
-	service.shutdown = make(ShutdownChannel)
+	service := newDockerService(config.Options.Docker_Socket)
 	// step: create the docker socket
 	service.client, err = dockerapi.NewClient("unix://" + config.Options.Docker_Socket)
 	if err != nil {
@@ -86,15 +107,33 @@ func NewDockerStore() (DockerStore, error) {
 	return service, nil
 }
 
+func newDockerService(socket string) *DockerService {
+	service := new(DockerService)
+	service.listeners = make(map[string][]DockerEvent, 0)
+	service.shutdown = make(ShutdownChannel)
+	service.seen = make(map[string]bool, 0)
+	service.retry_min = DOCKER_RETRY_MIN
+	service.retry_max = DOCKER_RETRY_MAX
+	service.http = &http.Client{
+		Transport: &http.Transport{
+			Dial: func(network, address string) (net.Conn, error) {
+				return net.Dial("unix", socket)
+			},
+		},
+	}
+	return service
+}
+
 func (r *DockerService) Close() {
 	glog.Infof("Shutting down the docker store")
-	r.shutdown <- true
+	close(r.shutdown)
 }
 
 // Retrieve a listing of the containers
-func (r *DockerService) List() ([]string, error) {
+//	all:		include the containers which have stopped, not just those running
+func (r *DockerService) List(all bool) ([]string, error) {
 	list := make([]string, 0)
-	containers, err := r.client.ListContainers(dockerapi.ListContainersOptions{})
+	containers, err := r.client.ListContainers(dockerapi.ListContainersOptions{All: all})
 	if err != nil {
 		return nil, err
 	}
@@ -112,11 +151,7 @@ func (r *DockerService) Watch(channel DockerEvent, event string) {
 		r.listeners[event] = make([]DockerEvent, 0)
 	}
 	r.listeners[event] = append(r.listeners[event], channel)
-	r.Once.Do(func() {
-		if err := r.processEvents(); err != nil {
-			glog.Fatalf("Failed to start the docker event processor, error: %s", err)
-		}
-	})
+	r.Once.Do(r.processEvents)
 }
 
 func (r *DockerService) HasFile(containerID, filename string) (bool, error) {
@@ -124,24 +159,6 @@ func (r *DockerService) HasFile(containerID, filename string) (bool, error) {
 	return false, nil
 }
 
-func (r *DockerService) GetFile(containerID, filename string) (string, error) {
-	var buffer bytes.Buffer
-	// step: construct the options
-	var options dockerapi.CopyFromContainerOptions
-	options.OutputStream = bufio.NewWriter(&buffer)
-	options.Container = containerID
-	options.Resource = filename
-
-	// step: extract the file
-	err := r.client.CopyFromContainer(options)
-	if err != nil {
-		glog.Errorf("Failed to copy the file: %s from the container: %s, error: %s",
-			filename, containerID[:12], err)
-		return "", err
-	}
-	return buffer.String(), nil
-}
-
 func (r *DockerService) Environment(containerId string) (map[string]string, error) {
 	c, err := r.client.InspectContainer(containerId)
 	if err != nil {
@@ -158,33 +175,96 @@ func (r *DockerService) Environment(containerId string) (map[string]string, erro
 	return environment, nil
 }
 
-func (r *DockerService) processEvents() error {
-	// step: add the docker events
-	updates := make(chan *dockerapi.APIEvents, 5)
-	if err := r.client.AddEventListener(updates); err != nil {
-		glog.Errorf("Failed to add ourselves as a docker events listen, error: %s", err)
+// The labels are not supported by the docker client, so we inspect the container and image directly
+type dockerInspect struct {
+	// the image id of the container
+	Image string
+	// the config of the container or image
+	Config *struct {
+		// the name of the image the container was created from
+		Image string
+		// the labels on the container or image
+		Labels map[string]string
+	}
+}
+
+func (r *DockerService) Labels(containerID string) (map[string]string, map[string]string, error) {
+	// step: inspect the container
+	var container dockerInspect
+	if err := r.inspect("/containers/"+containerID+"/json", &container); err != nil {
+		glog.Errorf("Failed to inspect the labels of container: %s, error: %s", containerID[:12], err)
+		return nil, nil, err
+	}
+	// step: inspect the image the container was created from
+	var image dockerInspect
+	if err := r.inspect("/images/"+container.Image+"/json", &image); err != nil {
+		glog.Errorf("Failed to inspect the labels of image: %s, container: %s, error: %s", container.Image, containerID[:12], err)
+		return nil, nil, err
+	}
+	return image.labels(), container.labels(), nil
+}
+
+func (r *DockerService) Image(containerID string) (string, error) {
+	var container dockerInspect
+	if err := r.inspect("/containers/"+containerID+"/json", &container); err != nil {
+		glog.Errorf("Failed to inspect the image of container: %s, error: %s", containerID[:12], err)
+		return "", err
+	}
+	if container.Config == nil || container.Config.Image == "" {
+		return container.Image, nil
+	}
+	return container.Config.Image, nil
+}
+
+func (r dockerInspect) labels() map[string]string {
+	if r.Config == nil || r.Config.Labels == nil {
+		return make(map[string]string, 0)
+	}
+	return r.Config.Labels
+}
+
+// Performs a GET on the docker api via the socket and decodes the json response
+//	uri:		the path of the resource, i.e. /containers/ID/json
+//	result:		the structure to decode the response into
+func (r *DockerService) inspect(uri string, result interface{}) error {
+	response, err := r.http.Get("http://docker" + uri)
+	if err != nil {
 		return err
 	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response from docker, uri: %s, code: %d", uri, response.StatusCode)
+	}
+	return json.NewDecoder(response.Body).Decode(result)
+}
 
-	// step: start the routine
-	go func() {
-		glog.Infof("Starting the docker event processor")
-		for {
-			select {
-			case event := <-updates:
-				glog.V(10).Infof("Recieved a docker event, id: %s, status: %s", event.ID[:12], event.Status)
-				if listeners, found := r.listeners[event.Status]; found {
-					for _, listener := range listeners {
-						// DON'T BLOCK ME DUDE !!
-						go func() {
-							listener <- event.ID
-						}()
-					}
-				}
-			case <-r.shutdown:
-				r.client.RemoveEventListener(updates)
-			}
-		}
-	}()
-	return nil
+func (r *DockerService) Execute(containerID, command string) (int, string, error) {
+	glog.V(5).Infof("Executing the command: %s in container: %s", command, containerID[:12])
+	// step: create the exec instance in the container
+	exec, err := r.client.CreateExec(dockerapi.CreateExecOptions{
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          []string{"/bin/sh", "-c", command},
+		Container:    containerID,
+	})
+	if err != nil {
+		glog.Errorf("Failed to create the exec in container: %s, error: %s", containerID[:12], err)
+		return -1, "", err
+	}
+	// step: start the exec and wait for it to finish
+	var output bytes.Buffer
+	if err := r.client.StartExec(exec.ID, dockerapi.StartExecOptions{
+		OutputStream: &output,
+		ErrorStream:  &output,
+	}); err != nil {
+		glog.Errorf("Failed to start the exec in container: %s, error: %s", containerID[:12], err)
+		return -1, output.String(), err
+	}
+	// step: retrieve the exit code
+	inspect, err := r.client.InspectExec(exec.ID)
+	if err != nil {
+		glog.Errorf("Failed to inspect the exec in container: %s, error: %s", containerID[:12], err)
+		return -1, output.String(), err
+	}
+	return inspect.ExitCode, output.String(), nil
 }
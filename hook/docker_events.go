@@ -0,0 +1,198 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gambol99/config-hook/metrics"
+
+	dockerapi "github.com/gambol99/go-dockerclient"
+	"github.com/golang/glog"
+)
+
+const (
+	// the initial wait before reconnecting to the docker event stream
+	DOCKER_RETRY_MIN = 1 * time.Second
+	// the maximum wait between reconnects to the docker event stream
+	DOCKER_RETRY_MAX = 60 * time.Second
+)
+
+// The health of the docker event stream
+type DockerHealth struct {
+	// the event stream is presently connected
+	Connected bool `json:"connected"`
+	// the error which caused the last disconnect
+	LastError string `json:"last_error,omitempty"`
+	// the time the last event was received
+	LastEvent time.Time `json:"last_event"`
+	// the number of times the event stream has been reconnected
+	Reconnects int `json:"reconnects"`
+}
+
+func (r *DockerService) Health() DockerHealth {
+	r.RLock()
+	defer r.RUnlock()
+	return r.health
+}
+
+// Streams the events from docker, reconnecting with an exponential backoff until shut down;
+// after a reconnect the events are replayed from the last event seen
+func (r *DockerService) processEvents() {
+	metrics.DefaultRegistry.OnCollect(func() {
+		connected := 0.0
+		if r.Health().Connected {
+			connected = 1
+		}
+		dockerConnected.Set(connected)
+	})
+	go func() {
+		glog.Infof("Starting the docker event processor")
+		backoff := r.retry_min
+		for {
+			connected, err := r.streamEvents()
+			select {
+			case <-r.shutdown:
+				glog.Infof("Stopped the docker event processor")
+				return
+			default:
+			}
+			// step: a successful connection resets the backoff
+			if connected {
+				backoff = r.retry_min
+			}
+			r.disconnected(err)
+			glog.Errorf("The docker event stream has failed, reconnecting in %s, error: %s", backoff, err)
+			select {
+			case <-time.After(backoff):
+			case <-r.shutdown:
+				return
+			}
+			if backoff *= 2; backoff > r.retry_max {
+				backoff = r.retry_max
+			}
+		}
+	}()
+}
+
+// Connects to the docker event stream and dispatches the events until the stream fails,
+// returning if the connection was made and the reason the stream failed
+func (r *DockerService) streamEvents() (bool, error) {
+	uri := "/events"
+	r.RLock()
+	if r.last_seen > 0 {
+		uri = fmt.Sprintf("%s?since=%d", uri, r.last_seen)
+	}
+	r.RUnlock()
+	response, err := r.http.Get("http://docker" + uri)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected response from docker, uri: %s, code: %d", uri, response.StatusCode)
+	}
+	r.connected()
+
+	// step: closing the stream on shutdown unblocks the decoder
+	done := make(ShutdownChannel)
+	defer close(done)
+	go func() {
+		select {
+		case <-r.shutdown:
+			response.Body.Close()
+		case <-done:
+		}
+	}()
+
+	decoder := json.NewDecoder(response.Body)
+	for {
+		var event dockerapi.APIEvents
+		if err := decoder.Decode(&event); err != nil {
+			if err == io.EOF {
+				err = errors.New("the event stream was closed by docker")
+			}
+			return true, err
+		}
+		r.dispatch(&event)
+	}
+}
+
+// Sends the event to those listening for the status, skipping any events already seen
+//	event:		the event from docker
+func (r *DockerService) dispatch(event *dockerapi.APIEvents) {
+	if event.ID == "" || event.Time == 0 || !r.record(event) {
+		return
+	}
+	glog.V(10).Infof("Recieved a docker event, id: %s, status: %s", event.ID[:12], event.Status)
+	dockerEvents.Inc(event.Status)
+	r.RLock()
+	defer r.RUnlock()
+	for _, listener := range r.listeners[event.Status] {
+		// DON'T BLOCK ME DUDE !!
+		go func(listener DockerEvent, id string) {
+			listener <- id
+		}(listener, event.ID)
+	}
+}
+
+// Records the event as seen, returning false if it was seen before, i.e. replayed
+//	event:		the event from docker
+func (r *DockerService) record(event *dockerapi.APIEvents) bool {
+	r.Lock()
+	defer r.Unlock()
+	key := event.ID + "/" + event.Status
+	switch {
+	case event.Time > r.last_seen:
+		r.last_seen = event.Time
+		r.seen = map[string]bool{key: true}
+	case event.Time == r.last_seen && !r.seen[key]:
+		r.seen[key] = true
+	default:
+		return false
+	}
+	r.health.LastEvent = time.Now()
+	return true
+}
+
+// Marks the event stream as connected
+func (r *DockerService) connected() {
+	r.Lock()
+	defer r.Unlock()
+	if r.health.LastError != "" {
+		glog.Infof("Reconnected to the docker event stream, replaying the events since: %d", r.last_seen)
+		r.health.Reconnects++
+		dockerReconnects.Inc()
+	}
+	// step: on the first connection we replay from now on a reconnect
+	if r.last_seen == 0 {
+		r.last_seen = time.Now().Unix()
+	}
+	r.health.Connected = true
+	r.health.LastError = ""
+}
+
+// Marks the event stream as disconnected
+//	err:		the reason for the disconnect
+func (r *DockerService) disconnected(err error) {
+	r.Lock()
+	defer r.Unlock()
+	r.health.Connected = false
+	r.health.LastError = err.Error()
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	TEST_EVENT = `{"status":"%s","id":"%s","from":"busybox","time":%d}`
+)
+
+/* a fake docker event stream, each connection is served the next batch of events */
+type fakeEvents struct {
+	sync.Mutex
+	// the batches of events served on each connection
+	batches [][]string
+	// the request uris received
+	requests []string
+	// closed to release the last connection
+	release chan bool
+}
+
+func (r *fakeEvents) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	r.Lock()
+	r.requests = append(r.requests, request.URL.RequestURI())
+	connection := len(r.requests)
+	r.Unlock()
+	if connection > len(r.batches) {
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	for _, event := range r.batches[connection-1] {
+		fmt.Fprintln(writer, event)
+	}
+	writer.(http.Flusher).Flush()
+	// step: the last batch keeps the stream open
+	if connection == len(r.batches) {
+		<-r.release
+	}
+}
+
+func receiveEvents(t *testing.T, channel DockerEvent, count int) []string {
+	list := make([]string, 0)
+	for i := 0; i < count; i++ {
+		select {
+		case id := <-channel:
+			list = append(list, id)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the docker events")
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+func TestDockerEventsReconnect(t *testing.T) {
+	directory, err := ioutil.TempDir("", "docker")
+	assert.Nil(t, err)
+	defer os.RemoveAll(directory)
+	socket := filepath.Join(directory, "docker.sock")
+	listener, err := net.Listen("unix", socket)
+	assert.Nil(t, err)
+
+	// step: the events must be after the first connection
+	now := time.Now().Unix() + 10
+	events := &fakeEvents{release: make(chan bool)}
+	events.batches = [][]string{
+		{
+			fmt.Sprintf(TEST_EVENT, DOCKER_START, TEST_CONTAINER, now),
+			fmt.Sprintf(TEST_EVENT, DOCKER_START, TEST_CONTAINER_OTHER, now+1),
+		},
+		{
+			fmt.Sprintf(TEST_EVENT, DOCKER_START, TEST_CONTAINER_OTHER, now+1),
+			fmt.Sprintf(TEST_EVENT, DOCKER_DESTROY, TEST_CONTAINER, now+2),
+		},
+	}
+	server := httptest.NewUnstartedServer(events)
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+
+	service := newDockerService(socket)
+	service.retry_min = 10 * time.Millisecond
+	created := make(DockerEvent, 10)
+	destroyed := make(DockerEvent, 10)
+	service.Watch(created, DOCKER_START)
+	service.Watch(destroyed, DOCKER_DESTROY)
+
+	assert.Equal(t, []string{TEST_CONTAINER, TEST_CONTAINER_OTHER}, receiveEvents(t, created, 2))
+	assert.Equal(t, []string{TEST_CONTAINER}, receiveEvents(t, destroyed, 1))
+	select {
+	case id := <-created:
+		t.Errorf("the replayed event for: %s should have been filtered", id)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	events.Lock()
+	assert.Equal(t, []string{"/events", fmt.Sprintf("/events?since=%d", now+1)}, events.requests)
+	events.Unlock()
+	health := service.Health()
+	assert.True(t, health.Connected)
+	assert.Equal(t, 1, health.Reconnects)
+
+	close(events.release)
+	service.Close()
+}
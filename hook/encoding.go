@@ -0,0 +1,156 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gambol99/config-hook/config"
+	"github.com/gambol99/config-hook/store"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// the content is stored base64 encoded
+	ENCODING_BASE64 = "base64"
+)
+
+// The metadata recorded alongside a key whose content has been encoded
+type KeyMetadata struct {
+	// the encoding of the value, i.e. base64
+	Encoding string `json:"encoding"`
+	// the content type of the decoded content, i.e. application/x-gzip
+	ContentType string `json:"content_type"`
+	// the size of the decoded content
+	Size int `json:"size"`
+}
+
+// Checks if the content is binary, i.e. not valid utf8 or holding a nul
+//	content:	the content read from the container
+func isBinary(content string) bool {
+	return !utf8.ValidString(content) || strings.ContainsRune(content, 0)
+}
+
+// Encodes the content for the store; binary content is base64 encoded and the metadata
+// describing it returned, otherwise the content is returned as is with no metadata
+//	content:	the content read from the container
+//	binary:		the hook is marked as binary, the content is always encoded
+func encodeContent(content string, binary bool) (string, *KeyMetadata) {
+	if !binary && !isBinary(content) {
+		return content, nil
+	}
+	return base64.StdEncoding.EncodeToString([]byte(content)), &KeyMetadata{
+		Encoding:    ENCODING_BASE64,
+		ContentType: http.DetectContentType([]byte(content)),
+		Size:        len(content),
+	}
+}
+
+// Checks if the metadata of the keys is being recorded
+func isMetadataTracked() bool {
+	return config.Options.Meta_Prefix != ""
+}
+
+// Retrieves the key in the store holding the metadata of a key
+//	key:		the key in the store
+func metadataKey(key string) string {
+	return path.Join(config.Options.Meta_Prefix, key)
+}
+
+// Records the metadata of a key; the record shares the ttl of the key
+//	key:		the key in the store
+//	metadata:	the metadata of the content
+//	ttl:		the ttl of the key, zero if the key does not expire
+func (r *ConfigHookService) setMetadata(key string, metadata *KeyMetadata, ttl time.Duration) error {
+	if !isMetadataTracked() {
+		return nil
+	}
+	content, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	if ttl > 0 {
+		return r.store.SetTTL(metadataKey(key), string(content), ttl)
+	}
+	return r.store.Set(metadataKey(key), string(content))
+}
+
+// Removes the metadata of a key, if any, once the content is no longer encoded
+//	key:		the key in the store
+func (r *ConfigHookService) removeMetadata(key string) error {
+	if !isMetadataTracked() {
+		return nil
+	}
+	found, err := r.store.Exists(metadataKey(key))
+	if err != nil || !found {
+		return err
+	}
+	return r.store.Delete(metadataKey(key))
+}
+
+// Keeps a record of the content published to a key by the hook file, recording or removing
+// the metadata of the key depending on whether the content was encoded
+//	file:		the hook file which published the key
+//	key:		the key in the store
+//	value:		the value stored in the key
+//	metadata:	the metadata of the content, nil if not encoded
+func (r *ConfigHookService) recordContent(file *HookFile, key, value string, metadata *KeyMetadata) {
+	if file.Keys == nil {
+		file.Keys = make(map[string]string, 0)
+	}
+	if file.Encoded == nil {
+		file.Encoded = make(map[string]*KeyMetadata, 0)
+	}
+	_, tracked := file.Keys[key]
+	file.Keys[key] = getChecksum(value)
+	if metadata != nil {
+		file.Encoded[key] = metadata
+		if err := r.setMetadata(key, metadata, keyTTL(file.TTL)); err != nil {
+			glog.Errorf("Failed to record the metadata of key: %s, hook: %s, error: %s", key, file.ID, err)
+		}
+		return
+	}
+	// step: the metadata may be left from when the content was encoded, or from before we started
+	if _, encoded := file.Encoded[key]; encoded || !tracked {
+		delete(file.Encoded, key)
+		if err := r.removeMetadata(key); err != nil {
+			glog.Errorf("Failed to remove the metadata of key: %s, hook: %s, error: %s", key, file.ID, err)
+		}
+	}
+}
+
+// Refreshes the ttl on the metadata of an encoded key, recording it again if it has expired
+//	file:		the hook file which published the key
+//	key:		the key in the store
+//	ttl:		the ttl of the key
+func (r *ConfigHookService) refreshMetadata(file *HookFile, key string, ttl time.Duration) {
+	metadata, found := file.Encoded[key]
+	if !found || !isMetadataTracked() {
+		return
+	}
+	err := r.store.Refresh(metadataKey(key), ttl)
+	if err == store.KeyNotFoundErr {
+		err = r.setMetadata(key, metadata, ttl)
+	}
+	if err != nil {
+		glog.Errorf("Failed to refresh the ttl on the metadata of key: %s, hook: %s, error: %s", key, file.ID, err)
+	}
+}
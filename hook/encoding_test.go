@@ -0,0 +1,95 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// the header of a gzip file
+	TEST_GZIP = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff"
+)
+
+func TestIsBinary(t *testing.T) {
+	assert.False(t, isBinary("haproxy config\n"))
+	assert.False(t, isBinary("héllo wörld"))
+	assert.True(t, isBinary(TEST_GZIP))
+	assert.True(t, isBinary("text with a \x00 nul"))
+}
+
+func TestEncodeContent(t *testing.T) {
+	value, metadata := encodeContent("haproxy config", false)
+	assert.Equal(t, "haproxy config", value)
+	assert.Nil(t, metadata)
+
+	value, metadata = encodeContent(TEST_GZIP, false)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(TEST_GZIP)), value)
+	assert.Equal(t, &KeyMetadata{Encoding: ENCODING_BASE64, ContentType: "application/x-gzip", Size: len(TEST_GZIP)}, metadata)
+
+	// step: a hook marked as binary is always encoded
+	value, metadata = encodeContent("plain text", true)
+	assert.Equal(t, "cGxhaW4gdGV4dA==", value)
+	assert.Equal(t, "text/plain; charset=utf-8", metadata.ContentType)
+}
+
+func TestServicePublishBinary(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_BUNDLE":         "/etc/bundle.gz;/env/bundle.gz",
+		"CONFIG_HOOK_FILE_BUNDLE_CLEANUP": "delete",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/bundle.gz": TEST_GZIP}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	node, err := service.store.Get("/env/bundle.gz")
+	assert.Nil(t, err)
+	decoded, err := base64.StdEncoding.DecodeString(node.Value)
+	assert.Nil(t, err)
+	assert.Equal(t, TEST_GZIP, string(decoded))
+	node, err = service.store.Get(metadataKey("/env/bundle.gz"))
+	assert.Nil(t, err)
+	metadata := new(KeyMetadata)
+	assert.Nil(t, json.Unmarshal([]byte(node.Value), metadata))
+	assert.Equal(t, ENCODING_BASE64, metadata.Encoding)
+	assert.Equal(t, "application/x-gzip", metadata.ContentType)
+
+	// step: the reconcile compares the encoded value, so nothing is republished
+	file := service.hooks[TEST_CONTAINER].files["BUNDLE"]
+	assert.True(t, service.isPublished(TEST_CONTAINER, file.IsPublished(), false, file.PublishedKeys()))
+
+	// step: the metadata is removed once the content is no longer binary
+	docker.files[TEST_CONTAINER]["/etc/bundle.gz"] = "plain text"
+	assert.Nil(t, service.publishFile(TEST_CONTAINER, file))
+	node, err = service.store.Get("/env/bundle.gz")
+	assert.Nil(t, err)
+	assert.Equal(t, "plain text", node.Value)
+	found, err := service.store.Exists(metadataKey("/env/bundle.gz"))
+	assert.Nil(t, err)
+	assert.False(t, found)
+
+	// step: the cleanup removes the metadata with the key
+	docker.files[TEST_CONTAINER]["/etc/bundle.gz"] = TEST_GZIP
+	assert.Nil(t, service.publishFile(TEST_CONTAINER, file))
+	service.processContainerDestruction(TEST_CONTAINER)
+	for _, key := range []string{"/env/bundle.gz", metadataKey("/env/bundle.gz")} {
+		found, err := service.store.Exists(key)
+		assert.Nil(t, err)
+		assert.False(t, found, "the key: %s should have been removed", key)
+	}
+}
@@ -27,8 +27,9 @@ import (
 
 func NewHooksConfig() *Hooks {
 	return &Hooks{
-		keys:  make(map[string]*HookKeys, 0),
-		files: make(map[string]*HookFile, 0),
+		keys:     make(map[string]*HookKeys, 0),
+		files:    make(map[string]*HookFile, 0),
+		shutdown: make(ShutdownChannel),
 	}
 }
 
@@ -53,6 +54,49 @@ type Hooks struct {
 	keys map[string]*HookKeys
 	// map of all the hook files
 	files map[string]*HookFile
+	// the hooks which were rejected during parsing or validation
+	rejected []*HookError
+	// closed when the container has gone
+	shutdown ShutdownChannel
+	// the image the container was created from
+	image string
+}
+
+// A hook which has been rejected and the reason why
+type HookError struct {
+	// the type of hook, FILE or KEYS, if known
+	Hook string `json:"hook"`
+	// the name of the hook or the variable it came from
+	Name string `json:"name"`
+	// the reason the hook was rejected
+	Error string `json:"error"`
+}
+
+// Records a hook as being rejected
+//	hook:		the type of hook
+//	name:		the name of the hook
+//	err:		the reason for the rejection
+func (r *Hooks) Reject(hook, name string, err error) {
+	r.rejected = append(r.rejected, &HookError{
+		Hook:  hook,
+		Name:  name,
+		Error: err.Error(),
+	})
+}
+
+// Stops the refreshes and keepalives of the hooks; it's safe to call more than once, the
+// caller holding the service lock
+func (r *Hooks) Stop() {
+	select {
+	case <-r.shutdown:
+	default:
+		close(r.shutdown)
+	}
+}
+
+// Checks if any of the hooks were rejected
+func (r Hooks) HasRejected() bool {
+	return len(r.rejected) > 0
 }
 
 func (r Hooks) IsHook(key string) bool {
@@ -65,7 +109,7 @@ func (r Hooks) ParseKey(key string) (string, string, string, error) {
 	if strings.HasPrefix(key, hook_file_prefix) {
 		matches, size := r.findMatches(key, hook_file_regex)
 		if size < 1 {
-			return HOOK_FILE, "", "", errors.New("Invalid config hook key: " + key + " does not match expectation")
+			return HOOK_FILE, "", "", errors.New("Invalid config hook key: " + key + " does not match expectation, the elements are KEY, CHECK, EXEC, FLAGS, CLEANUP, INTERVAL and TTL")
 		}
 		if size == 1 {
 			return HOOK_FILE, matches[0], "", nil
@@ -75,13 +119,43 @@ func (r Hooks) ParseKey(key string) (string, string, string, error) {
 
 	if strings.HasPrefix(key, hook_keys_prefix) {
 		matches, size := r.findMatches(key, hook_keys_regex)
-		if size != 1 {
+		if size < 1 {
 			return HOOK_KEYS, "", "", errors.New("Invalid config key for keys config: " + key)
 		}
-		return HOOK_KEYS, matches[0], "", nil
+		if size == 1 {
+			return HOOK_KEYS, matches[0], "", nil
+		}
+		return HOOK_KEYS, matches[0], matches[1], nil
 	}
 
-	return "", "", "", nil
+	return "", "", "", errors.New("Invalid config hook key: " + key + " is not a known hook type")
+}
+
+// Checks if the label is a config hook label
+//	label:		the name of the image or container label
+func (r Hooks) IsLabel(label string) bool {
+	return hook_label_prefix != "" && strings.HasPrefix(label, hook_label_prefix)
+}
+
+// Parses the label and extracts the type, the name and the element if has it; the names are
+// upper cased so the labels and environment variables refer to the same hooks
+//	label:		the config hook label, i.e. config-hook.file.haproxy.path
+func (r Hooks) ParseLabel(label string) (string, string, string, error) {
+	matches := hook_label_regex.FindStringSubmatch(label)
+	if matches == nil {
+		return "", "", "", errors.New("Invalid config hook label: " + label + " does not match expectation")
+	}
+	hook, name, element := strings.ToUpper(matches[1]), strings.ToUpper(matches[2]), strings.ToUpper(matches[3])
+	if hook == HOOK_KEYS {
+		switch element {
+		case "EXEC", "CHECK":
+			return hook, "", "", errors.New("Invalid config hook label: " + label + ", keys do not support the element: " + matches[3])
+		}
+	}
+	if hook == HOOK_FILE && (element == "FORMAT" || element == "MAPPING") {
+		return hook, "", "", errors.New("Invalid config hook label: " + label + ", files do not support the element: " + matches[3])
+	}
+	return hook, name, element, nil
 }
 
 func (r *Hooks) Files(id string) *HookFile {
@@ -109,18 +183,31 @@ func (r Hooks) HasHooks() bool {
 	return false
 }
 
-func (r Hooks) Validate() error {
+// Substitutes the variables in all the hooks, any errors invalidate the hook
+//	variables:	the maps of variables to resolve from, in order of precedence
+func (r Hooks) Expand(variables ...map[string]string) {
+	for _, file := range r.files {
+		file.Expand(variables...)
+	}
+	for _, keys := range r.keys {
+		keys.Expand(variables...)
+	}
+}
+
+func (r *Hooks) Validate() error {
 	// step: validate the hook files
-	for id, _ := range r.files {
-		if err := r.Validate(); err != nil {
+	for id, file := range r.files {
+		if err := file.Valid(); err != nil {
 			glog.Errorf("invalid hook file config, error: %s", err)
+			r.Reject(HOOK_FILE, id, err)
 			delete(r.files, id)
 		}
 	}
 	// step: validate the keys
-	for id, _ := range r.keys {
-		if err := r.Validate(); err != nil {
+	for id, keys := range r.keys {
+		if _, err := keys.Valid(); err != nil {
 			glog.Errorf("invalid hook keys config, error: %s", err)
+			r.Reject(HOOK_KEYS, id, err)
 			delete(r.keys, id)
 		}
 	}
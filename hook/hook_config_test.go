@@ -43,11 +43,20 @@ func TestKeys(t *testing.T) {
 	assert.Equal(t, len(c.keys), 1)
 }
 
+func TestHooksValidate(t *testing.T) {
+	c := NewHooksConfig()
+	c.Files("valid").Set("", "/etc/haproxy.cfg")
+	c.Files("valid").Set("KEY", "/env/prod/haproxy.cfg")
+	c.Files("invalid").Set("", "/etc/nginx.conf")
+	c.Keys("keys").File = ""
+	assert.Nil(t, c.Validate())
+	assert.Equal(t, len(c.files), 1)
+	assert.NotNil(t, c.files["valid"])
+	assert.Equal(t, len(c.keys), 0)
+}
+
 func TestFindMatches(t *testing.T) {
-	hook_file_regex = regexp.MustCompile(fmt.Sprintf("^%s%s_([[:alpha:]]+)[$_]?(KEY|CHECK|EXEC|FLAGS)?",
-		"CONFIG_HOOK_", HOOK_FILE))
-	hook_keys_regex = regexp.MustCompile(fmt.Sprintf("^%s%s_([[:alpha:]]+)$",
-		"CONFIG_HOOK_", HOOK_KEYS))
+	setHookPrefix("CONFIG_HOOK_")
 
 	src := "CONFIG_HOOK_FILE_NAME"
 	c := NewHooksConfig()
@@ -75,6 +84,7 @@ func TestIsHook(t *testing.T) {
 }
 
 func TestParseKey(t *testing.T) {
+	setHookPrefix("CONFIG_HOOK_")
 	c := NewHooksConfig()
 	assert.NotNil(t, c)
 	assert.Equal(t, config.Options.Runtime_Prefix, "CONFIG_HOOK_")
@@ -99,3 +109,80 @@ func TestParseKey(t *testing.T) {
 	assert.Nil(t, err, "expected not to be an error, error: "+fmt.Sprintf("%s", err))
 	assert.Equal(t, element, "CHECK")
 }
+
+func TestParseKeyUnknownElement(t *testing.T) {
+	setHookPrefix("CONFIG_HOOK_")
+	c := NewHooksConfig()
+	for _, key := range []string{"CONFIG_HOOK_FILE_X_FORMAT", "CONFIG_HOOK_FILE_X_EXECC", "CONFIG_HOOK_FILE_X_PATH", "CONFIG_HOOK_FILE_X_", "CONFIG_HOOK_FILE_X_KEY_TTL"} {
+		_, _, _, err := c.ParseKey(key)
+		assert.NotNil(t, err, "the key: %s should be rejected", key)
+	}
+}
+
+func TestServiceUnknownFileElement(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":        "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_FILE_HAPROXY_FORMAT": "/etc/other.cfg;/env/other.cfg",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	// step: the unknown element is rejected rather than overwriting the path and key of the file
+	hooks := service.hooks[TEST_CONTAINER]
+	assert.NotNil(t, hooks)
+	file := hooks.files["HAPROXY"]
+	assert.Equal(t, "/etc/haproxy.cfg", file.File)
+	assert.Equal(t, "/env/haproxy.cfg", file.Key)
+	assert.True(t, hooks.HasRejected())
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+}
+
+func TestParseKeyKeys(t *testing.T) {
+	setHookPrefix("CONFIG_HOOK_")
+	c := NewHooksConfig()
+	hook, name, element, err := c.ParseKey("CONFIG_HOOK_KEYS_APP")
+	assert.Nil(t, err)
+	assert.Equal(t, hook, "KEYS")
+	assert.Equal(t, name, "APP")
+	assert.Equal(t, element, "")
+
+	hook, name, element, err = c.ParseKey("CONFIG_HOOK_KEYS_APP_FLAGS")
+	assert.Nil(t, err)
+	assert.Equal(t, name, "APP")
+	assert.Equal(t, element, "FLAGS")
+}
+
+func TestParseLabel(t *testing.T) {
+	setLabelPrefix("config-hook")
+	c := NewHooksConfig()
+	assert.True(t, c.IsLabel("config-hook.file.haproxy"))
+	assert.False(t, c.IsLabel("com.example.version"))
+
+	hook, name, element, err := c.ParseLabel("config-hook.file.haproxy.path")
+	assert.Nil(t, err)
+	assert.Equal(t, "FILE", hook)
+	assert.Equal(t, "HAPROXY", name)
+	assert.Equal(t, "PATH", element)
+
+	hook, name, element, err = c.ParseLabel("config-hook.keys.app")
+	assert.Nil(t, err)
+	assert.Equal(t, "KEYS", hook)
+	assert.Equal(t, "APP", name)
+	assert.Equal(t, "", element)
+
+	hook, name, element, err = c.ParseLabel("config-hook.keys.app.mapping")
+	assert.Nil(t, err)
+	assert.Equal(t, "MAPPING", element)
+
+	_, _, _, err = c.ParseLabel("config-hook.keys.app.exec")
+	assert.Error(t, err)
+	_, _, _, err = c.ParseLabel("config-hook.file.haproxy.mapping")
+	assert.Error(t, err)
+	_, _, _, err = c.ParseLabel("config-hook.file.haproxy.unknown")
+	assert.Error(t, err)
+	_, _, _, err = c.ParseLabel("config-hook.service.haproxy")
+	assert.Error(t, err)
+}
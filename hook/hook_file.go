@@ -14,8 +14,10 @@ limitations under the License.
 package hook
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -23,6 +25,7 @@ func NewHookFile(id string) *HookFile {
 	h := new(HookFile)
 	h.ID = id
 	h.Exec = new(HookExec)
+	h.Flags = make(HookFlags, 0)
 	return h
 }
 
@@ -36,7 +39,25 @@ type HookFile struct {
 	// the exec which should be run when content changed
 	Exec *HookExec `json:"exec"`
 	// the flags associated to the config
-	Flags string `json:"flags"`
+	Flags HookFlags `json:"flags"`
+	// the cleanup policy for the key when the container is destroyed
+	Cleanup string `json:"cleanup"`
+	// the checksum of the content last published
+	Checksum string `json:"checksum"`
+	// the keys published, more than one for a directory, and the checksums of the values stored
+	Keys map[string]string `json:"keys,omitempty"`
+	// the keys whose content was base64 encoded and the metadata recorded for them
+	Encoded map[string]*KeyMetadata `json:"encoded,omitempty"`
+	// the interval to re-read the file for changes, zero uses the agent default
+	Interval time.Duration `json:"interval"`
+	// the ttl of the keys published, kept alive while the container runs, zero uses the agent default
+	TTL time.Duration `json:"ttl"`
+	// the last time the content was published to the store
+	LastPublished time.Time `json:"last_published"`
+	// the error from the last publish, empty if successful
+	LastError string `json:"last_error"`
+	// an error in the values of the hook, if any
+	invalid error
 }
 
 func (r HookFile) String() string {
@@ -45,22 +66,142 @@ func (r HookFile) String() string {
 }
 
 type HookExec struct {
+	sync.Mutex
 	// the last time the exec was ran
-	LastRun time.Time
+	LastRun time.Time `json:"last_run"`
 	// the last exit code
-	LastExitCode int
+	LastExitCode int `json:"last_exit_code"`
+	// the last exit code from the check
+	LastCheckExitCode int `json:"last_check_exit_code"`
 	// the exec command which should be run
 	Exec string `json:"command"`
 	// the check command which should be performed before hand
 	Check string `json:"check"`
+	// indicates the exec is presently running
+	running bool
+	// indicates another run was requested while running
+	pending bool
 }
 
-func (r HookExec) String() string {
+func (r *HookExec) String() string {
 	return fmt.Sprintf("command: %s, check: %s", r.Exec, r.Check)
 }
 
-func (r *HookFile) Set(element string, value interface{}) {
+func (r *HookExec) MarshalJSON() ([]byte, error) {
+	r.Lock()
+	defer r.Unlock()
+	return json.Marshal(map[string]interface{}{
+		"command":              r.Exec,
+		"check":                r.Check,
+		"last_run":             r.LastRun,
+		"last_exit_code":       r.LastExitCode,
+		"last_check_exit_code": r.LastCheckExitCode,
+		"running":              r.running,
+	})
+}
+
+// Marks the start of a run, if a run is already in progress the run is queued to be repeated
+// once finished and false is returned
+//	onetime:	indicates the exec should only ever be run once
+func (r *HookExec) start(onetime bool) bool {
+	r.Lock()
+	defer r.Unlock()
+	if onetime && !r.LastRun.IsZero() {
+		return false
+	}
+	if r.running {
+		r.pending = !onetime
+		return false
+	}
+	r.running = true
+	return true
+}
+
+// Marks the end of a run, returning true if another run was requested in the meantime
+func (r *HookExec) finish() bool {
+	r.Lock()
+	defer r.Unlock()
+	if r.pending {
+		r.pending = false
+		return true
+	}
+	r.running = false
+	return false
+}
+
+// Records the exit code of the check
+func (r *HookExec) checked(code int) {
+	r.Lock()
+	defer r.Unlock()
+	r.LastCheckExitCode = code
+}
+
+// Records the exit code and time of the exec
+func (r *HookExec) ran(code int) {
+	r.Lock()
+	defer r.Unlock()
+	r.LastRun = time.Now()
+	r.LastExitCode = code
+}
+
+// Indicates if a command has been set to run on changes
+func (r *HookExec) HasExec() bool {
+	return r.Exec != ""
+}
+
+// Indicates if a check has been set to run prior to the command
+func (r *HookExec) HasCheck() bool {
+	return r.Check != ""
+}
+
+// Records the outcome of a publish of the file content into the store
+//	err:	the error returned from the publish, nil if successful
+func (r *HookFile) Published(err error) {
+	r.LastPublished = time.Now()
+	r.LastError = ""
+	if err != nil {
+		r.LastError = err.Error()
+	}
+}
+
+// Indicates if the last publish of the file was successful
+func (r HookFile) IsPublished() bool {
+	return !r.LastPublished.IsZero() && r.LastError == ""
+}
+
+// Checks if the key is the key of the hook or one of the keys published from a directory
+//	key:		the key in the store
+func (r HookFile) HasKey(key string) bool {
+	if isSameKey(r.Key, key) {
+		return true
+	}
+	for name, _ := range r.Keys {
+		if isSameKey(name, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Retrieves the keys published by the hook and the checksums of the values stored, falling
+// back to the key of the hook if nothing has been published
+func (r HookFile) PublishedKeys() map[string]string {
+	if len(r.Keys) > 0 {
+		return r.Keys
+	}
+	return map[string]string{r.Key: r.Checksum}
+}
+
+func (r *HookFile) Set(element string, value interface{}) (err error) {
+	// step: any error in the values invalidates the hook
+	defer func() {
+		if err != nil {
+			r.invalid = err
+		}
+	}()
 	switch element {
+	case "PATH":
+		r.File = value.(string)
 	case "KEY":
 		r.Key = value.(string)
 	case "EXEC":
@@ -68,13 +209,82 @@ func (r *HookFile) Set(element string, value interface{}) {
 	case "CHECK":
 		r.Exec.Check = value.(string)
 	case "FLAGS":
-		r.Flags = value.(string)
+		flags, err := ParseFlags(value.(string))
+		if err != nil {
+			return err
+		}
+		r.Flags = flags
+	case "INTERVAL":
+		interval, err := parseDuration(element, value.(string))
+		if err != nil {
+			return err
+		}
+		r.Interval = interval
+	case "TTL":
+		ttl, err := parseDuration(element, value.(string))
+		if err != nil {
+			return err
+		}
+		r.TTL = ttl
+	case "CLEANUP":
+		policy, err := parseCleanupPolicy(value.(string))
+		if err != nil {
+			return err
+		}
+		r.Cleanup = policy
 	case "":
-		r.File = value.(string)
+		return r.SetCompact(value.(string))
+	}
+	return nil
+}
+
+// Sets the fields of the hook from the compact format, PATH;KEY;EXEC;CHECK;FLAGS
+//	value:		the compact value of the hook
+func (r *HookFile) SetCompact(value string) error {
+	fields, err := splitCompact(value)
+	if err != nil {
+		return err
+	}
+	if len(fields) > 5 {
+		return errors.New("the hook file value: " + value + " has too many fields, expected PATH;KEY;EXEC;CHECK;FLAGS")
 	}
+	// step: only set the fields which have been given
+	elements := []*string{&r.File, &r.Key, &r.Exec.Exec, &r.Exec.Check}
+	for index, field := range fields {
+		if field == "" {
+			continue
+		}
+		if index == len(elements) {
+			return r.Set("FLAGS", field)
+		}
+		*elements[index] = field
+	}
+	return nil
+}
+
+// Substitutes any %NAME% placeholders in the path, key, exec and check of the hook; an
+// unresolved placeholder in the path or key invalidates the hook, in the exec and check it's
+// left as is for the shell
+//	variables:	the maps of variables to resolve from, in order of precedence
+func (r *HookFile) Expand(variables ...map[string]string) error {
+	for _, element := range []*string{&r.File, &r.Key} {
+		expanded, err := expandVariables(*element, variables...)
+		if err != nil {
+			r.invalid = err
+			return err
+		}
+		*element = expanded
+	}
+	for _, element := range []*string{&r.Exec.Exec, &r.Exec.Check} {
+		*element = expandCommand(*element, variables...)
+	}
+	return nil
 }
 
 func (r HookFile) Valid() error {
+	if r.invalid != nil {
+		return r.invalid
+	}
 	if r.ID == "" {
 		return errors.New("the hook config does not contain a id")
 	}
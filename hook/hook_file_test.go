@@ -14,6 +14,7 @@ limitations under the License.
 package hook
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,8 +31,9 @@ func TestSet(t *testing.T) {
 	config := NewHookFile("test")
 	config.Set("KEY", "key")
 	assert.Equal(t, config.Key, "key")
-	config.Set("FLAGS", "flags")
-	assert.Equal(t, config.Flags, "flags")
+	assert.Nil(t, config.Set("FLAGS", "OT"))
+	assert.True(t, config.Flags.IsOneTime())
+	assert.NotNil(t, config.Set("FLAGS", "flags"))
 	config.Set("EXEC", "exec")
 	assert.Equal(t, config.Exec.Exec, "exec")
 	config.Set("CHECK", "check")
@@ -48,3 +50,72 @@ func TestValidate(t *testing.T) {
 	err = config.Valid()
 	assert.Nil(t, err, "the error should not have been raised")
 }
+
+func TestPublished(t *testing.T) {
+	config := NewHookFile("test")
+	assert.False(t, config.IsPublished())
+	config.Published(errors.New("failed"))
+	assert.False(t, config.IsPublished())
+	assert.Equal(t, config.LastError, "failed")
+	assert.False(t, config.LastPublished.IsZero())
+	config.Published(nil)
+	assert.True(t, config.IsPublished())
+	assert.Equal(t, config.LastError, "")
+}
+
+func TestSetCompact(t *testing.T) {
+	config := NewHookFile("test")
+	err := config.Set("", `/config/haproxy.cfg;/env/prod/haproxy.cfg;"/usr/bin/a; /usr/bin/b";sh -c 'a;b';OT`)
+	assert.Nil(t, err)
+	assert.Equal(t, config.File, "/config/haproxy.cfg")
+	assert.Equal(t, config.Key, "/env/prod/haproxy.cfg")
+	assert.Equal(t, config.Exec.Exec, "/usr/bin/a; /usr/bin/b")
+	assert.Equal(t, config.Exec.Check, "sh -c 'a;b'")
+	assert.True(t, config.Flags.IsOneTime())
+
+	config = NewHookFile("test")
+	err = config.Set("", `/config/haproxy.cfg;/env/prod/haproxy.cfg;/usr/bin/a \; /usr/bin/b`)
+	assert.Nil(t, err)
+	assert.Equal(t, config.Exec.Exec, "/usr/bin/a ; /usr/bin/b")
+	assert.Equal(t, config.Exec.Check, "")
+
+	// the long form should override the compact form
+	config.Set("KEY", "/env/dev/haproxy.cfg")
+	assert.Equal(t, config.Key, "/env/dev/haproxy.cfg")
+
+	assert.NotNil(t, NewHookFile("test").Set("", `/config;"/usr/bin/a`))
+	assert.NotNil(t, NewHookFile("test").Set("", "a;b;c;d;e;f"))
+}
+
+func TestInvalidFlagsInvalidates(t *testing.T) {
+	config := NewHookFile("test")
+	assert.NotNil(t, config.Set("", "/usr/hello;/usr/key;;;BAD"))
+	assert.NotNil(t, config.Valid())
+}
+
+func TestExpand(t *testing.T) {
+	config := NewHookFile("test")
+	config.Set("", "/etc/%NAME%.cfg;/env/%ENVIRONMENT%/%NAME%.cfg;/usr/bin/%NAME%_restart")
+	assert.Nil(t, config.Expand(map[string]string{"NAME": "haproxy"}, map[string]string{"ENVIRONMENT": "prod"}))
+	assert.Equal(t, config.File, "/etc/haproxy.cfg")
+	assert.Equal(t, config.Key, "/env/prod/haproxy.cfg")
+	assert.Equal(t, config.Exec.Exec, "/usr/bin/haproxy_restart")
+	assert.Nil(t, config.Valid())
+
+	config.Set("KEY", "/env/%MISSING%/haproxy.cfg")
+	assert.NotNil(t, config.Expand(map[string]string{}))
+	assert.NotNil(t, config.Valid())
+}
+
+func TestExpandCommands(t *testing.T) {
+	config := NewHookFile("test")
+	assert.Nil(t, config.Set("EXEC", `date +%H%M > /tmp/%NAME%.updated`))
+	assert.Nil(t, config.Set("CHECK", `printf "%s%s" a b`))
+	config.Set("KEY", "/env/%%NAME%%/100%%")
+	assert.Nil(t, config.Set("", "/etc/haproxy.cfg"))
+	assert.Nil(t, config.Expand(map[string]string{"NAME": "haproxy"}))
+	// step: the placeholders naming no variable are left for the shell
+	assert.Equal(t, "date +%H%M > /tmp/haproxy.updated", config.Exec.Exec)
+	assert.Equal(t, `printf "%s%s" a b`, config.Exec.Check)
+	assert.Equal(t, "/env/%NAME%/100%", config.Key)
+}
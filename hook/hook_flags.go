@@ -0,0 +1,77 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+const (
+	// the content is published once and never overwritten
+	FLAG_ONETIME = "OT"
+	// the content is binary and always stored base64 encoded
+	FLAG_BINARY = "BIN"
+)
+
+// the flags which are supported by the hooks
+var hook_flags = map[string]bool{
+	FLAG_ONETIME: true,
+	FLAG_BINARY:  true,
+}
+
+// A set of flags associated to a hook
+type HookFlags map[string]bool
+
+// Parses a comma separated list of flags, i.e. OT,... into a flag set
+//	value:		the comma separated list of flags
+func ParseFlags(value string) (HookFlags, error) {
+	flags := make(HookFlags, 0)
+	for _, flag := range strings.Split(value, ",") {
+		flag = strings.ToUpper(strings.TrimSpace(flag))
+		if flag == "" {
+			continue
+		}
+		if _, found := hook_flags[flag]; !found {
+			return nil, errors.New("the flag: " + flag + " is not a supported hook flag")
+		}
+		flags[flag] = true
+	}
+	return flags, nil
+}
+
+// Checks if the flag has been set
+func (r HookFlags) Has(flag string) bool {
+	return r[flag]
+}
+
+// Checks if the hook is a one time hook
+func (r HookFlags) IsOneTime() bool {
+	return r.Has(FLAG_ONETIME)
+}
+
+// Checks if the hook is marked as binary
+func (r HookFlags) IsBinary() bool {
+	return r.Has(FLAG_BINARY)
+}
+
+func (r HookFlags) String() string {
+	list := make([]string, 0)
+	for flag, _ := range r {
+		list = append(list, flag)
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
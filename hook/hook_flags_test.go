@@ -0,0 +1,36 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFlags(t *testing.T) {
+	flags, err := ParseFlags("")
+	assert.Nil(t, err)
+	assert.False(t, flags.IsOneTime())
+	flags, err = ParseFlags(" ot ,")
+	assert.Nil(t, err)
+	assert.True(t, flags.IsOneTime())
+	assert.Equal(t, "OT", flags.String())
+	flags, err = ParseFlags("OT,bin")
+	assert.Nil(t, err)
+	assert.True(t, flags.IsBinary())
+	assert.Equal(t, "BIN,OT", flags.String())
+	_, err = ParseFlags("OT,BAD")
+	assert.NotNil(t, err)
+}
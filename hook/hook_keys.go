@@ -14,15 +14,19 @@ limitations under the License.
 package hook
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 )
 
 func NewHookKeys(id string) *HookKeys {
 	return &HookKeys{
 		ID:    id,
 		File:  "",
-		Flags: "",
+		Flags: make(HookFlags, 0),
+		Keys:  make(map[string]string, 0),
 	}
 }
 
@@ -31,15 +35,42 @@ type HookKeys struct {
 	ID string `json:"id"`
 	// the file which holds the content
 	File string `json:"file"`
+	// the base path the keys are published beneath, empty for none
+	Key string `json:"key"`
+	// the mapping of the key names, keep, lower or path, empty keeps the names as is
+	Mapping string `json:"mapping"`
 	// the flags associated to the config
-	Flags string `json:"flags"`
+	Flags HookFlags `json:"flags"`
+	// the cleanup policy for the keys when the container is destroyed
+	Cleanup string `json:"cleanup"`
+	// the format of the file, plain, env, json or yaml, empty to detect from the extension
+	Format string `json:"format"`
+	// the keys published and the checksum of their content
+	Keys map[string]string `json:"keys"`
+	// the checksum of the file content last published
+	Checksum string `json:"checksum"`
+	// the interval to re-read the file for changes, zero uses the agent default
+	Interval time.Duration `json:"interval"`
+	// the ttl of the keys published, kept alive while the container runs, zero uses the agent default
+	TTL time.Duration `json:"ttl"`
+	// the last time the keys were published to the store
+	LastPublished time.Time `json:"last_published"`
+	// the error from the last publish, empty if successful
+	LastError string `json:"last_error"`
+	// an error in the values of the hook, if any
+	invalid error
+	// the lines from the file which could not be parsed
+	Invalid []string `json:"invalid"`
 }
 
 func (r HookKeys) String() string {
-	return fmt.Sprintf("id: %s, file: %s, flags: %s", r.ID, r.File, r.Flags)
+	return fmt.Sprintf("id: %s, file: %s, key: %s, flags: %s", r.ID, r.File, r.Key, r.Flags)
 }
 
 func (r HookKeys) Valid() (bool, error) {
+	if r.invalid != nil {
+		return false, r.invalid
+	}
 	if r.ID == "" {
 		return false, errors.New("the hook config does not contain a id")
 	}
@@ -48,3 +79,168 @@ func (r HookKeys) Valid() (bool, error) {
 	}
 	return true, nil
 }
+
+func (r *HookKeys) Set(element string, value interface{}) (err error) {
+	// step: any error in the values invalidates the hook
+	defer func() {
+		if err != nil {
+			r.invalid = err
+		}
+	}()
+	switch element {
+	case "PATH":
+		r.File = value.(string)
+	case "KEY":
+		r.Key = value.(string)
+	case "FLAGS":
+		flags, err := ParseFlags(value.(string))
+		if err != nil {
+			return err
+		}
+		r.Flags = flags
+	case "INTERVAL":
+		interval, err := parseDuration(element, value.(string))
+		if err != nil {
+			return err
+		}
+		r.Interval = interval
+	case "TTL":
+		ttl, err := parseDuration(element, value.(string))
+		if err != nil {
+			return err
+		}
+		r.TTL = ttl
+	case "CLEANUP":
+		policy, err := parseCleanupPolicy(value.(string))
+		if err != nil {
+			return err
+		}
+		r.Cleanup = policy
+	case "FORMAT":
+		format, err := parseKeysFormat(value.(string))
+		if err != nil {
+			return err
+		}
+		r.Format = format
+	case "MAPPING":
+		mapping, err := parseKeyMapping(value.(string))
+		if err != nil {
+			return err
+		}
+		r.Mapping = mapping
+	case "":
+		return r.SetCompact(value.(string))
+	}
+	return nil
+}
+
+// Sets the fields of the hook from the compact format, PATH;FLAGS
+//	value:		the compact value of the hook
+func (r *HookKeys) SetCompact(value string) error {
+	fields, err := splitCompact(value)
+	if err != nil {
+		return err
+	}
+	if len(fields) > 2 {
+		return errors.New("the hook keys value: " + value + " has too many fields, expected PATH;FLAGS")
+	}
+	r.File = fields[0]
+	if len(fields) > 1 && fields[1] != "" {
+		return r.Set("FLAGS", fields[1])
+	}
+	return nil
+}
+
+// Substitutes any %NAME% placeholders in the path and base key of the hook, an unresolved
+// placeholder invalidates the hook
+//	variables:	the maps of variables to resolve from, in order of precedence
+func (r *HookKeys) Expand(variables ...map[string]string) error {
+	for _, element := range []*string{&r.File, &r.Key} {
+		expanded, err := expandVariables(*element, variables...)
+		if err != nil {
+			r.invalid = err
+			return err
+		}
+		*element = expanded
+	}
+	return nil
+}
+
+// Records the outcome of a publish of the keys into the store
+//	err:	the error returned from the publish, nil if successful
+func (r *HookKeys) Published(err error) {
+	r.LastPublished = time.Now()
+	r.LastError = ""
+	if err != nil {
+		r.LastError = err.Error()
+	}
+}
+
+// Indicates if the last publish of the keys was successful
+func (r HookKeys) IsPublished() bool {
+	return !r.LastPublished.IsZero() && r.LastError == ""
+}
+
+// Parses the content of a keys file into key pairs according to the format of the file, the
+// names being mapped onto the keys in the store
+//	content:	the content of the keys file
+func (r *HookKeys) Parse(content string) (map[string]string, []error) {
+	var pairs map[string]string
+	var errs []error
+	switch keysFormat(r.Format, r.File) {
+	case FORMAT_ENV:
+		pairs, errs = parseEnvKeys(content)
+	case FORMAT_JSON:
+		pairs, errs = parseJSONKeys(content)
+	case FORMAT_YAML:
+		pairs, errs = parseYAMLKeys(content)
+	default:
+		pairs, errs = parsePlainKeys(content)
+	}
+	pairs, mapping_errs := mapKeys(pairs, r.Key, r.Mapping)
+	errs = append(errs, mapping_errs...)
+	// step: keep a record of the invalid lines
+	r.Invalid = make([]string, 0)
+	for _, err := range errs {
+		r.Invalid = append(r.Invalid, err.Error())
+	}
+	return pairs, errs
+}
+
+// Parses a newline separated list of KEY=VALUE pairs, the values are taken as is. Blank
+// lines and lines starting with a # are ignored, a duplicate key overrides the former value
+//	content:	the content of the keys file
+func parsePlainKeys(content string) (map[string]string, []error) {
+	pairs := make(map[string]string, 0)
+	errs := make([]error, 0)
+	line_number := 0
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line_number++
+		line := strings.TrimSpace(scanner.Text())
+		// step: skip any blank lines or comments
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// step: split the line into key and value
+		elements := strings.SplitN(line, "=", 2)
+		if len(elements) != 2 {
+			errs = append(errs, fmt.Errorf("line %d: %q is not a KEY=VALUE pair", line_number, line))
+			continue
+		}
+		key := strings.TrimSpace(elements[0])
+		value := strings.TrimSpace(elements[1])
+		if key == "" || strings.ContainsAny(key, " \t") {
+			errs = append(errs, fmt.Errorf("line %d: %q has an invalid key", line_number, line))
+			continue
+		}
+		if _, found := pairs[key]; found {
+			errs = append(errs, fmt.Errorf("line %d: duplicate key: %s, overriding the previous value", line_number, key))
+		}
+		pairs[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return pairs, errs
+}
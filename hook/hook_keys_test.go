@@ -23,7 +23,6 @@ func TestHookKeyValid(t *testing.T) {
 	key := NewHookKeys("test_key")
 	assert.NotNil(t, key)
 	key.File = "/opt/file/keys"
-	key.Flags = ""
 	valid, err := key.Valid()
 	assert.Nil(t, err)
 	assert.True(t, valid)
@@ -32,3 +31,35 @@ func TestHookKeyValid(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.False(t, valid)
 }
+
+func TestHookKeyParse(t *testing.T) {
+	key := NewHookKeys("test_key")
+	content := `
+# a comment
+KEY_ONE=VALUE_ONE
+  KEY_TWO = VALUE_TWO
+
+KEY_THREE=a=b=c
+KEY_ONE=VALUE_OVERRIDE
+NOT A PAIR
+=VALUE
+`
+	pairs, errs := key.Parse(content)
+	assert.Equal(t, 3, len(pairs))
+	assert.Equal(t, "VALUE_OVERRIDE", pairs["KEY_ONE"])
+	assert.Equal(t, "VALUE_TWO", pairs["KEY_TWO"])
+	assert.Equal(t, "a=b=c", pairs["KEY_THREE"])
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, 3, len(key.Invalid))
+}
+
+func TestHookKeySetCompact(t *testing.T) {
+	key := NewHookKeys("test_key")
+	assert.Nil(t, key.Set("", "/opt/file/keys;OT"))
+	assert.Equal(t, key.File, "/opt/file/keys")
+	assert.True(t, key.Flags.IsOneTime())
+	assert.Nil(t, key.Set("", "/opt/file/keys"))
+	assert.True(t, key.Flags.IsOneTime())
+	assert.NotNil(t, key.Set("", "/opt/file/keys;XX"))
+	assert.NotNil(t, key.Set("", "/opt/file/keys;OT;extra"))
+}
@@ -0,0 +1,445 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// KEY=VALUE lines, the values are taken as is
+	FORMAT_PLAIN = "plain"
+	// a .env file, KEY=VALUE lines with quoting, export prefixes and multiline values
+	FORMAT_ENV = "env"
+	// a json document, the nested values are flattened into paths
+	FORMAT_JSON = "json"
+	// a yaml document, the nested values are flattened into paths
+	FORMAT_YAML = "yaml"
+)
+
+const (
+	// the names of the keys are kept as is
+	MAPPING_KEEP = "keep"
+	// the names of the keys are lower cased, i.e. DB_HOST becomes db_host
+	MAPPING_LOWER = "lower"
+	// the names are lower cased and split on _ into paths, i.e. DB_HOST becomes /db/host
+	MAPPING_PATH = "path"
+)
+
+// the maximum number of nodes a yaml keys file can expand to through its aliases
+const YAML_MAX_NODES = 100000
+
+// Parses and validates the format of a keys file
+//	format:		the name of the format, i.e. plain, env, json or yaml
+func parseKeysFormat(format string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	switch format {
+	case FORMAT_PLAIN, FORMAT_ENV, FORMAT_JSON, FORMAT_YAML:
+		return format, nil
+	case "yml":
+		return FORMAT_YAML, nil
+	}
+	return "", errors.New("the format: " + format + " is invalid, must be plain, env, json or yaml")
+}
+
+// Retrieves the format of a keys file, detecting it from the extension of the file if not set
+//	format:		the format of the hook
+//	filename:	the path of the keys file
+func keysFormat(format, filename string) string {
+	if format != "" {
+		return format
+	}
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".env":
+		return FORMAT_ENV
+	case ".json":
+		return FORMAT_JSON
+	case ".yml", ".yaml":
+		return FORMAT_YAML
+	}
+	return FORMAT_PLAIN
+}
+
+// Parses a .env file; the lines can be prefixed with export, the values can be single quoted,
+// taken literally, or double quoted, where the escapes \n, \t, \", \\ and \$ are expanded, and
+// a quoted value can span multiple lines. An unquoted value ends at a # after a space
+//	content:	the content of the keys file
+func parseEnvKeys(content string) (map[string]string, []error) {
+	pairs := make(map[string]string, 0)
+	errs := make([]error, 0)
+	lines := strings.Split(content, "\n")
+	for index := 0; index < len(lines); index++ {
+		line_number := index + 1
+		line := strings.TrimSpace(lines[index])
+		// step: skip any blank lines or comments
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "export ") || strings.HasPrefix(line, "export\t") {
+			line = strings.TrimSpace(line[len("export"):])
+		}
+		// step: split the line into key and value
+		elements := strings.SplitN(line, "=", 2)
+		if len(elements) != 2 {
+			errs = append(errs, fmt.Errorf("line %d: %q is not a KEY=VALUE pair", line_number, line))
+			continue
+		}
+		key := strings.TrimSpace(elements[0])
+		if key == "" || strings.ContainsAny(key, " \t") {
+			errs = append(errs, fmt.Errorf("line %d: %q has an invalid key", line_number, line))
+			continue
+		}
+		value := strings.TrimLeft(elements[1], " \t")
+		if strings.HasPrefix(value, `"`) || strings.HasPrefix(value, "'") {
+			// step: a quoted value continues until the closing quote, which may be on a later line
+			quoted := value
+			end := closingQuote(quoted)
+			for end < 0 && index+1 < len(lines) {
+				index++
+				quoted += "\n" + strings.TrimRight(lines[index], "\r")
+				end = closingQuote(quoted)
+			}
+			if end < 0 {
+				errs = append(errs, fmt.Errorf("line %d: the value of key: %s has no closing quote", line_number, key))
+				continue
+			}
+			if remainder := strings.TrimSpace(quoted[end+1:]); remainder != "" && !strings.HasPrefix(remainder, "#") {
+				errs = append(errs, fmt.Errorf("line %d: unexpected content after the quoted value of key: %s", line_number, key))
+				continue
+			}
+			value = quoted[1:end]
+			if quoted[0] == '"' {
+				value = unescapeEnv(value)
+			}
+		} else {
+			if position := strings.Index(value, " #"); position >= 0 {
+				value = value[:position]
+			}
+			value = strings.TrimSpace(value)
+		}
+		if _, found := pairs[key]; found {
+			errs = append(errs, fmt.Errorf("line %d: duplicate key: %s, overriding the previous value", line_number, key))
+		}
+		pairs[key] = value
+	}
+	return pairs, errs
+}
+
+// Finds the position of the quote closing a quoted value, -1 if not found; a double quote
+// can be escaped with a backslash
+//	value:		the value starting with the opening quote
+func closingQuote(value string) int {
+	quote := value[0]
+	for index := 1; index < len(value); index++ {
+		switch {
+		case quote == '"' && value[index] == '\\':
+			index++
+		case value[index] == quote:
+			return index
+		}
+	}
+	return -1
+}
+
+// Expands the escapes in a double quoted value, unknown escapes are left as is
+//	value:		the content of the quoted value
+func unescapeEnv(value string) string {
+	var expanded []byte
+	for index := 0; index < len(value); index++ {
+		if value[index] != '\\' || index+1 == len(value) {
+			expanded = append(expanded, value[index])
+			continue
+		}
+		index++
+		switch value[index] {
+		case 'n':
+			expanded = append(expanded, '\n')
+		case 't':
+			expanded = append(expanded, '\t')
+		case 'r':
+			expanded = append(expanded, '\r')
+		case '"', '\\', '$':
+			expanded = append(expanded, value[index])
+		default:
+			expanded = append(expanded, '\\', value[index])
+		}
+	}
+	return string(expanded)
+}
+
+// Parses a json document, which must be an object, flattening the nested values into paths
+//	content:	the content of the keys file
+func parseJSONKeys(content string) (map[string]string, []error) {
+	var document interface{}
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return map[string]string{}, []error{fmt.Errorf("invalid json document: %s", err)}
+	}
+	if _, ok := document.(map[string]interface{}); !ok {
+		return map[string]string{}, []error{errors.New("the json document must be an object")}
+	}
+	pairs := make(map[string]string, 0)
+	flattenKeys("/", document, pairs)
+	return pairs, []error{}
+}
+
+// Parses a yaml document, which must be a map, flattening the nested values into paths; the
+// scalars are kept as written, i.e. version: 1.10 is 1.10, the aliases and merge keys are
+// resolved and the block scalars keep their line breaks
+//	content:	the content of the keys file
+func parseYAMLKeys(content string) (map[string]string, []error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return map[string]string{}, []error{fmt.Errorf("invalid yaml document: %s", err)}
+	}
+	if len(document.Content) == 0 {
+		return map[string]string{}, []error{}
+	}
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return map[string]string{}, []error{fmt.Errorf("invalid yaml document: line %d: the document must be a map", root.Line)}
+	}
+	flattener := &yamlFlattener{
+		pairs:   make(map[string]string, 0),
+		parents: make(map[*yaml.Node]bool, 0),
+	}
+	if err := flattener.flatten("/", root); err != nil {
+		return map[string]string{}, []error{fmt.Errorf("invalid yaml document: %s", err)}
+	}
+	return flattener.pairs, []error{}
+}
+
+// Flattens the nodes of a yaml document into paths as flattenKeys does, resolving the aliases
+type yamlFlattener struct {
+	// the key pairs being built
+	pairs map[string]string
+	// the nodes being flattened, used to reject an anchor which contains itself
+	parents map[*yaml.Node]bool
+	// the number of nodes flattened, bounding the expansion of the aliases
+	visited int
+}
+
+// Flattens the node into paths beneath the prefix
+//	prefix:		the path of the node
+//	node:		the yaml node
+func (r *yamlFlattener) flatten(prefix string, node *yaml.Node) error {
+	if r.parents[node] {
+		return fmt.Errorf("line %d: the anchor: %s contains itself", node.Line, node.Anchor)
+	}
+	if err := r.visit(); err != nil {
+		return err
+	}
+	r.parents[node] = true
+	defer delete(r.parents, node)
+	switch node.Kind {
+	case yaml.AliasNode:
+		return r.flatten(prefix, node.Alias)
+	case yaml.MappingNode:
+		entries, err := r.entries(node)
+		if err != nil {
+			return err
+		}
+		for name, value := range entries {
+			if err := r.flatten(path.Join(prefix, name), value); err != nil {
+				return err
+			}
+		}
+	case yaml.SequenceNode:
+		for index, item := range node.Content {
+			if err := r.flatten(path.Join(prefix, strconv.Itoa(index)), item); err != nil {
+				return err
+			}
+		}
+	case yaml.ScalarNode:
+		r.pairs[prefix] = node.Value
+		if node.Tag == "!!null" {
+			r.pairs[prefix] = ""
+		}
+	}
+	return nil
+}
+
+// Counts a node flattened or merged, failing once the document has expanded too far
+func (r *yamlFlattener) visit() error {
+	if r.visited++; r.visited > YAML_MAX_NODES {
+		return fmt.Errorf("the document expands to more than %d nodes", YAML_MAX_NODES)
+	}
+	return nil
+}
+
+// Collects the entries of a yaml map; the entries of any merge keys are added after, so they
+// never override an entry of the map itself
+//	node:		the yaml map
+func (r *yamlFlattener) entries(node *yaml.Node) (map[string]*yaml.Node, error) {
+	entries := make(map[string]*yaml.Node, 0)
+	merges := make([]*yaml.Node, 0)
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		key, value := node.Content[index], node.Content[index+1]
+		if key.Kind == yaml.ScalarNode && key.Tag == "!!merge" {
+			merges = append(merges, value)
+			continue
+		}
+		if key.Kind != yaml.ScalarNode {
+			return nil, fmt.Errorf("line %d: the key must be a scalar", key.Line)
+		}
+		if _, found := entries[key.Value]; found {
+			return nil, fmt.Errorf("line %d: duplicate key: %s", key.Line, key.Value)
+		}
+		entries[key.Value] = value
+	}
+	for _, merge := range merges {
+		sources := []*yaml.Node{merge}
+		if merge.Kind == yaml.SequenceNode {
+			sources = merge.Content
+		}
+		for _, source := range sources {
+			if source.Kind == yaml.AliasNode {
+				source = source.Alias
+			}
+			if source.Kind != yaml.MappingNode {
+				return nil, fmt.Errorf("line %d: a merge key must reference a map", merge.Line)
+			}
+			if r.parents[source] {
+				return nil, fmt.Errorf("line %d: the anchor: %s contains itself", merge.Line, source.Anchor)
+			}
+			if err := r.visit(); err != nil {
+				return nil, err
+			}
+			r.parents[source] = true
+			merged, err := r.entries(source)
+			delete(r.parents, source)
+			if err != nil {
+				return nil, err
+			}
+			for name, value := range merged {
+				if _, found := entries[name]; !found {
+					entries[name] = value
+				}
+			}
+		}
+	}
+	return entries, nil
+}
+
+// Flattens the nested maps and lists of a document into paths, i.e. {"db": {"host": "a"}}
+// becomes /db/host=a and the items of a list are indexed, i.e. /hosts/0
+//	prefix:		the path of the value
+//	value:		the value in the document
+//	pairs:		the key pairs being built
+func flattenKeys(prefix string, value interface{}, pairs map[string]string) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, item := range value {
+			flattenKeys(path.Join(prefix, name), item, pairs)
+		}
+	case []interface{}:
+		for index, item := range value {
+			flattenKeys(path.Join(prefix, strconv.Itoa(index)), item, pairs)
+		}
+	case nil:
+		pairs[prefix] = ""
+	case string:
+		pairs[prefix] = value
+	default:
+		pairs[prefix] = fmt.Sprintf("%v", value)
+	}
+}
+
+// Parses and validates the mapping of the key names
+//	mapping:	the name of the mapping, i.e. keep, lower or path
+func parseKeyMapping(mapping string) (string, error) {
+	mapping = strings.ToLower(strings.TrimSpace(mapping))
+	switch mapping {
+	case MAPPING_KEEP, MAPPING_LOWER, MAPPING_PATH:
+		return mapping, nil
+	}
+	return "", errors.New("the mapping: " + mapping + " is invalid, must be keep, lower or path")
+}
+
+// Maps the name of a key according to the mapping and places it beneath the base path; a name
+// with a .. segment, i.e. ../../etc/passwd or A_.._B with the path mapping, is rejected, as is
+// one which resolves outside of the base
+//	name:		the name of the key from the keys file
+//	base:		the base path of the keys, empty for none
+//	mapping:	the mapping of the key names
+func mapKey(name, base, mapping string) (string, error) {
+	key := name
+	switch mapping {
+	case MAPPING_LOWER:
+		key = strings.ToLower(key)
+	case MAPPING_PATH:
+		key = "/" + strings.Replace(strings.ToLower(key), "_", "/", -1)
+	}
+	for _, element := range strings.Split(key, "/") {
+		if element == ".." {
+			return "", fmt.Errorf("the key: %s contains a .. segment", name)
+		}
+	}
+	if mapping == MAPPING_PATH {
+		key = path.Clean(key)
+	}
+	if base == "" {
+		return key, nil
+	}
+	key = path.Join(base, key)
+	if prefix := strings.TrimSuffix(path.Clean(base), "/") + "/"; key != path.Clean(base) && !strings.HasPrefix(key, prefix) {
+		return "", fmt.Errorf("the key: %s resolves outside of the base: %s", name, base)
+	}
+	return key, nil
+}
+
+// Maps the names of the key pairs onto the keys in the store; a name which is rejected, maps to
+// nothing or to the same key as another is reported and skipped, the names being taken in
+// sorted order
+//	pairs:		the key pairs parsed from the keys file
+//	base:		the base path of the keys, empty for none
+//	mapping:	the mapping of the key names
+func mapKeys(pairs map[string]string, base, mapping string) (map[string]string, []error) {
+	errs := make([]error, 0)
+	names := make([]string, 0, len(pairs))
+	for name := range pairs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mapped := make(map[string]string, len(pairs))
+	sources := make(map[string]string, len(pairs))
+	for _, name := range names {
+		key, err := mapKey(name, base, mapping)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if key == "/" || key == "." || (base != "" && key == path.Clean(base)) {
+			errs = append(errs, fmt.Errorf("the key: %s does not map to a valid key", name))
+			continue
+		}
+		if source, found := sources[key]; found {
+			errs = append(errs, fmt.Errorf("the keys: %s and %s both map to the key: %s, skipping %s", source, name, key, name))
+			continue
+		}
+		sources[key] = name
+		mapped[key] = pairs[name]
+	}
+	return mapped, errs
+}
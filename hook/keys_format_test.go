@@ -0,0 +1,292 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKeysFormat(t *testing.T) {
+	for value, expected := range map[string]string{"env": FORMAT_ENV, " JSON ": FORMAT_JSON, "yml": FORMAT_YAML, "plain": FORMAT_PLAIN} {
+		format, err := parseKeysFormat(value)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, format)
+	}
+	_, err := parseKeysFormat("toml")
+	assert.NotNil(t, err)
+}
+
+func TestKeysFormat(t *testing.T) {
+	assert.Equal(t, FORMAT_PLAIN, keysFormat("", "/etc/settings"))
+	assert.Equal(t, FORMAT_ENV, keysFormat("", "/app/.env"))
+	assert.Equal(t, FORMAT_JSON, keysFormat("", "/app/settings.JSON"))
+	assert.Equal(t, FORMAT_YAML, keysFormat("", "/app/application.yml"))
+	assert.Equal(t, FORMAT_PLAIN, keysFormat(FORMAT_PLAIN, "/app/application.yml"))
+}
+
+func TestParseEnvKeys(t *testing.T) {
+	content := "# database\n" +
+		"export DB_HOST=db.local # the primary\n" +
+		"DB_PASS='p@ss \\n # not a comment'\n" +
+		"GREETING=\"hello\\n\\\"world\\\" \\$HOME\"\n" +
+		"CERT=\"-----BEGIN-----\n" +
+		"abc\n" +
+		"-----END-----\"\n" +
+		"EMPTY=\n" +
+		"BAD LINE\n" +
+		"TRAILING=\"x\" y\n" +
+		"OPEN='never closed\n"
+	pairs, errs := parseEnvKeys(content)
+	assert.Equal(t, map[string]string{
+		"DB_HOST":  "db.local",
+		"DB_PASS":  "p@ss \\n # not a comment",
+		"GREETING": "hello\n\"world\" $HOME",
+		"CERT":     "-----BEGIN-----\nabc\n-----END-----",
+		"EMPTY":    "",
+	}, pairs)
+	assert.Equal(t, 3, len(errs), "%v", errs)
+}
+
+func TestParseJSONKeys(t *testing.T) {
+	pairs, errs := parseJSONKeys(`{"db": {"primary": {"host": "a", "port": 5432}}, "debug": true, "hosts": ["x", "y"], "none": null}`)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]string{
+		"/db/primary/host": "a",
+		"/db/primary/port": "5432",
+		"/debug":           "true",
+		"/hosts/0":         "x",
+		"/hosts/1":         "y",
+		"/none":            "",
+	}, pairs)
+	_, errs = parseJSONKeys(`["a"]`)
+	assert.Equal(t, 1, len(errs))
+	_, errs = parseJSONKeys(`{"a":`)
+	assert.Equal(t, 1, len(errs))
+}
+
+func TestParseYAMLKeys(t *testing.T) {
+	pairs, errs := parseYAMLKeys("spring:\n  datasource:\n    url: jdbc:postgresql://db/app\n    pool: 10\nservers:\n- name: a\n")
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]string{
+		"/spring/datasource/url":  "jdbc:postgresql://db/app",
+		"/spring/datasource/pool": "10",
+		"/servers/0/name":         "a",
+	}, pairs)
+	_, errs = parseYAMLKeys("a: [1\n")
+	assert.Equal(t, 1, len(errs))
+}
+
+// an application.yml as shipped by a spring service, sharing the datasource settings through an
+// anchor and a merge key, with a block scalar for the certificate
+const TEST_APPLICATION_YML = `
+defaults: &datasource
+  driver: org.postgresql.Driver
+  pool: 10
+  timeout: 30s
+
+spring:
+  application:
+    name: billing
+  datasource:
+    primary:
+      <<: *datasource
+      url: jdbc:postgresql://db-primary/billing
+    replica:
+      <<: *datasource
+      url: jdbc:postgresql://db-replica/billing
+      pool: 20
+
+logging:
+  name: &name billing-api
+
+server:
+  port: 8443
+  version: 1.10
+  debug: ~
+  ssl:
+    enabled: true
+    certificate: |
+      -----BEGIN CERTIFICATE-----
+      MIIBszCCAVmgAwIBAgIUQ
+      -----END CERTIFICATE-----
+  hosts: [api.local, *name]
+  banner: >
+    billing
+    service
+`
+
+func TestParseYAMLKeysApplication(t *testing.T) {
+	pairs, errs := parseYAMLKeys(TEST_APPLICATION_YML)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]string{
+		"/defaults/driver":                   "org.postgresql.Driver",
+		"/defaults/pool":                     "10",
+		"/defaults/timeout":                  "30s",
+		"/spring/application/name":           "billing",
+		"/spring/datasource/primary/driver":  "org.postgresql.Driver",
+		"/spring/datasource/primary/pool":    "10",
+		"/spring/datasource/primary/timeout": "30s",
+		"/spring/datasource/primary/url":     "jdbc:postgresql://db-primary/billing",
+		"/spring/datasource/replica/driver":  "org.postgresql.Driver",
+		"/spring/datasource/replica/pool":    "20",
+		"/spring/datasource/replica/timeout": "30s",
+		"/spring/datasource/replica/url":     "jdbc:postgresql://db-replica/billing",
+		"/server/port":                       "8443",
+		"/server/version":                    "1.10",
+		"/server/debug":                      "",
+		"/server/ssl/enabled":                "true",
+		"/server/ssl/certificate":            "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUQ\n-----END CERTIFICATE-----\n",
+		"/server/hosts/0":                    "api.local",
+		"/server/hosts/1":                    "billing-api",
+		"/server/banner":                     "billing service\n",
+		"/logging/name":                      "billing-api",
+	}, pairs)
+}
+
+func TestParseYAMLKeysInvalid(t *testing.T) {
+	pairs, errs := parseYAMLKeys("")
+	assert.Empty(t, errs)
+	assert.Empty(t, pairs)
+	for _, content := range []string{
+		"- a\n- b\n",
+		"a: 1\na: 2\n",
+		"a: &a\n  b: *a\n",
+		"a: &a\n  <<: *a\n",
+		"a: 1\nb:\n  <<: [1]\n",
+		"? [a]\n: 1\n",
+	} {
+		_, errs := parseYAMLKeys(content)
+		assert.Equal(t, 1, len(errs), "the content: %q should be invalid", content)
+	}
+	// step: the aliases can't expand the document without bound
+	bomb := "a: &a [x, x, x, x, x, x, x, x, x, x]\n"
+	for index, name := range []string{"b", "c", "d", "e", "f", "g"} {
+		previous := string('a' + rune(index))
+		bomb += fmt.Sprintf("%s: &%s [*%s, *%s, *%s, *%s, *%s, *%s, *%s, *%s, *%s, *%s]\n", name, name, previous, previous, previous, previous, previous, previous, previous, previous, previous, previous)
+	}
+	_, errs = parseYAMLKeys(bomb)
+	assert.Equal(t, 1, len(errs))
+	assert.Contains(t, errs[0].Error(), "expands")
+}
+
+func TestServicePublishKeysFormat(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_KEYS_APP":        "/app/application.yml",
+		"CONFIG_HOOK_KEYS_ENV":        "/app/settings",
+		"CONFIG_HOOK_KEYS_ENV_FORMAT": "env",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/app/application.yml": "db:\n  primary:\n    host: db.local\n",
+		"/app/settings":        "export NAME=\"config hook\"\n",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	for key, value := range map[string]string{"/db/primary/host": "db.local", "NAME": "config hook"} {
+		node, err := service.store.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, value, node.Value)
+	}
+	assert.NotNil(t, NewHookKeys("test").Set("FORMAT", "ini"))
+}
+
+func TestParseKeyMapping(t *testing.T) {
+	for _, mapping := range []string{"keep", "LOWER", " path "} {
+		_, err := parseKeyMapping(mapping)
+		assert.Nil(t, err, "the mapping: %s should be valid", mapping)
+	}
+	_, err := parseKeyMapping("upper")
+	assert.NotNil(t, err)
+}
+
+func TestMapKeys(t *testing.T) {
+	pairs := map[string]string{"DB_PRIMARY_HOST": "db.local", "Name": "app"}
+	mapped, errs := mapKeys(pairs, "", "")
+	assert.Empty(t, errs)
+	assert.Equal(t, pairs, mapped)
+
+	mapped, errs = mapKeys(pairs, "", MAPPING_PATH)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]string{"/db/primary/host": "db.local", "/name": "app"}, mapped)
+
+	mapped, errs = mapKeys(pairs, "/env/prod", MAPPING_LOWER)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]string{"/env/prod/db_primary_host": "db.local", "/env/prod/name": "app"}, mapped)
+
+	mapped, errs = mapKeys(pairs, "/env/prod", MAPPING_KEEP)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]string{"/env/prod/DB_PRIMARY_HOST": "db.local", "/env/prod/Name": "app"}, mapped)
+
+	// step: the names which collide or map to nothing are skipped
+	mapped, errs = mapKeys(map[string]string{"DB__HOST": "a", "db_host": "b", "__": "c"}, "", MAPPING_PATH)
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, map[string]string{"/db/host": "a"}, mapped)
+	mapped, errs = mapKeys(map[string]string{"__": "c"}, "/env/prod", MAPPING_PATH)
+	assert.Equal(t, 1, len(errs))
+	assert.Empty(t, mapped)
+}
+
+func TestMapKeyEscapes(t *testing.T) {
+	// step: a name can't climb out of the base, whatever the mapping
+	for _, mapping := range []string{MAPPING_KEEP, MAPPING_LOWER, MAPPING_PATH} {
+		for _, name := range []string{"../../etc/passwd", "a/../../b", "..", "/.."} {
+			_, err := mapKey(name, "/env/prod", mapping)
+			assert.NotNil(t, err, "the name: %s should be rejected with the mapping: %s", name, mapping)
+			_, err = mapKey(name, "", mapping)
+			assert.NotNil(t, err, "the name: %s should be rejected without a base, mapping: %s", name, mapping)
+		}
+	}
+	_, err := mapKey("DB_.._.._HOST", "/env/prod", MAPPING_PATH)
+	assert.NotNil(t, err)
+	// step: dots within a name are not segments
+	key, err := mapKey("app..name", "/env/prod", MAPPING_KEEP)
+	assert.Nil(t, err)
+	assert.Equal(t, "/env/prod/app..name", key)
+	key, err = mapKey("DB_HOST", "/", MAPPING_PATH)
+	assert.Nil(t, err)
+	assert.Equal(t, "/db/host", key)
+
+	// step: the rejected names are reported and skipped, the rest are still published
+	mapped, errs := mapKeys(map[string]string{"../secret": "a", "NAME": "b"}, "/env/prod", MAPPING_LOWER)
+	assert.Equal(t, 1, len(errs))
+	assert.Contains(t, errs[0].Error(), "../secret")
+	assert.Equal(t, map[string]string{"/env/prod/name": "b"}, mapped)
+}
+
+func TestServicePublishKeysMapping(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"ENVIRONMENT":                  "prod",
+		"CONFIG_HOOK_KEYS_APP":         "/app/app.env",
+		"CONFIG_HOOK_KEYS_APP_KEY":     "/env/%ENVIRONMENT%/app",
+		"CONFIG_HOOK_KEYS_APP_MAPPING": "path",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/app/app.env": "DB_PRIMARY_HOST=db.local\nDB_PRIMARY_PORT=5432\n",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	for key, value := range map[string]string{"/env/prod/app/db/primary/host": "db.local", "/env/prod/app/db/primary/port": "5432"} {
+		node, err := service.store.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, value, node.Value)
+	}
+	keys := service.hooks[TEST_CONTAINER].keys["APP"]
+	assert.Equal(t, "/env/prod/app", keys.Key)
+	assert.Empty(t, keys.Invalid)
+	assert.NotNil(t, NewHookKeys("test").Set("MAPPING", "upper"))
+}
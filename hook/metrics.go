@@ -0,0 +1,51 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"github.com/gambol99/config-hook/metrics"
+)
+
+var (
+	containersProcessed = metrics.RegisterCounter("config_hook_containers_processed_total",
+		"The number of containers which have been checked for hooks")
+	hooksParsed = metrics.RegisterCounter("config_hook_hooks_parsed_total",
+		"The number of valid hooks parsed from the containers", "type")
+	hooksRejected = metrics.RegisterCounter("config_hook_hooks_rejected_total",
+		"The number of hooks rejected during parsing or validation", "type")
+	storeWrites = metrics.RegisterCounter("config_hook_store_writes_total",
+		"The number of writes made to the store", "backend")
+	storeWriteFailures = metrics.RegisterCounter("config_hook_store_write_failures_total",
+		"The number of writes to the store which failed", "backend")
+	dockerEvents = metrics.RegisterCounter("config_hook_docker_events_total",
+		"The number of docker events received", "status")
+	dockerConnected = metrics.RegisterGauge("config_hook_docker_connected",
+		"Set to 1 when the docker event stream is connected")
+	dockerReconnects = metrics.RegisterCounter("config_hook_docker_reconnects_total",
+		"The number of times the docker event stream has been reconnected")
+	execRuns = metrics.RegisterCounter("config_hook_exec_runs_total",
+		"The number of EXEC and CHECK commands run in the containers", "type", "exit_code")
+	execDuration = metrics.RegisterHistogram("config_hook_exec_duration_seconds",
+		"The duration of the EXEC and CHECK commands run in the containers", metrics.DefaultBuckets, "type")
+	reconcileRuns = metrics.RegisterCounter("config_hook_reconcile_runs_total",
+		"The number of reconcile passes between the running containers and the managed hooks")
+	reconcileRepairs = metrics.RegisterCounter("config_hook_reconcile_repairs_total",
+		"The number of containers and hooks brought back in sync by the reconcile", "action")
+	keyRefreshes = metrics.RegisterCounter("config_hook_key_refreshes_total",
+		"The number of refreshes of the ttl on the keys published", "result")
+	keyConflicts = metrics.RegisterCounter("config_hook_key_conflicts_total",
+		"The number of publishes to a key owned by another container", "policy")
+	queueDepth = metrics.RegisterGauge("config_hook_queue_depth",
+		"The number of items queued on the internal channels", "queue")
+)
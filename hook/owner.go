@@ -0,0 +1,348 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gambol99/config-hook/config"
+	"github.com/gambol99/config-hook/store"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// the first container to publish the key keeps it
+	CONFLICT_FIRST = "first"
+	// the last container to publish the key takes it over
+	CONFLICT_LAST = "last"
+	// the hook is refused and reported
+	CONFLICT_REFUSE = "refuse"
+	// the number of times we try to claim a key whose owner is changing
+	OWNER_CLAIM_ATTEMPTS = 3
+)
+
+// The owner of a key published into the store
+type KeyOwner struct {
+	// the host the agent is running on
+	Host string `json:"host"`
+	// the container which published the key
+	Container string `json:"container"`
+	// the image the container was created from
+	Image string `json:"image"`
+	// the type of hook, FILE or KEYS
+	Hook string `json:"hook"`
+	// the name of the hook
+	Name string `json:"name"`
+	// the time the key was last published
+	Updated time.Time `json:"updated"`
+}
+
+func (r KeyOwner) String() string {
+	return fmt.Sprintf("container: %s, image: %s, host: %s, hook: %s_%s", shortID(r.Container), r.Image, r.Host, r.Hook, r.Name)
+}
+
+// Checks if the owner is the same container on the same host
+//	owner:		the owner to compare
+func (r KeyOwner) IsSame(owner *KeyOwner) bool {
+	return r.Host == owner.Host && r.Container == owner.Container
+}
+
+// The error returned when a key is owned by another container
+type KeyConflictErr struct {
+	// the key in the store
+	Key string
+	// the container which owns the key
+	Owner *KeyOwner
+}
+
+func (r *KeyConflictErr) Error() string {
+	return fmt.Sprintf("the key: %s is owned by %s", r.Key, r.Owner)
+}
+
+// Parses and validates a conflict policy
+//	policy:		the name of the policy, i.e. first, last or refuse
+func parseConflictPolicy(policy string) (string, error) {
+	policy = strings.ToLower(strings.TrimSpace(policy))
+	switch policy {
+	case CONFLICT_FIRST, CONFLICT_LAST, CONFLICT_REFUSE:
+		return policy, nil
+	}
+	return "", errors.New("the conflict policy: " + policy + " is invalid, must be first, last or refuse")
+}
+
+// Checks if the owners of the keys are being recorded
+func isOwnerTracked() bool {
+	return config.Options.Owner_Prefix != ""
+}
+
+// Retrieves the key in the store holding the owner of a key
+//	key:		the key in the store
+func ownerKey(key string) string {
+	return path.Join(config.Options.Owner_Prefix, key)
+}
+
+// Shortens a container id for logging, the full id is kept in the owner
+//	containerId:	the id of the container
+func shortID(containerId string) string {
+	if len(containerId) > 12 {
+		return containerId[:12]
+	}
+	return containerId
+}
+
+// Creates the owner record for a hook of a container we are managing
+//	containerId:	the container holding the hook
+//	hook:			the type of hook
+//	name:			the name of the hook
+func (r *ConfigHookService) newOwner(containerId, hook, name string) *KeyOwner {
+	owner := &KeyOwner{
+		Host:      r.hostname,
+		Container: containerId,
+		Hook:      hook,
+		Name:      name,
+	}
+	if hooks, found := r.hooks[containerId]; found {
+		owner.Image = hooks.image
+	}
+	return owner
+}
+
+// Retrieves the owner of the key from the store, nil if the key has no owner recorded, and the
+// index of the owner record, zero if there is none
+//	key:		the key in the store
+func (r *ConfigHookService) keyOwner(key string) (*KeyOwner, uint64, error) {
+	found, err := r.store.Exists(ownerKey(key))
+	if err != nil || !found {
+		return nil, 0, err
+	}
+	node, err := r.store.Get(ownerKey(key))
+	if err != nil {
+		return nil, 0, err
+	}
+	if node == nil || node.IsDir() {
+		return nil, 0, nil
+	}
+	owner := new(KeyOwner)
+	if err := json.Unmarshal([]byte(node.Value), owner); err != nil {
+		glog.Warningf("The owner of key: %s is invalid, ignoring it, error: %s", key, err)
+		return nil, node.Index, nil
+	}
+	return owner, node.Index, nil
+}
+
+// Checks if the owner is a container on this host we are no longer managing
+//	owner:		the owner of the key
+func (r *ConfigHookService) isStaleOwner(owner *KeyOwner) bool {
+	if owner.Host != r.hostname {
+		return false
+	}
+	_, found := r.hooks[owner.Container]
+	return !found
+}
+
+// Checks the ownership of the key before we publish to it, applying the conflict policy if the
+// key is owned by another container. The owner is stale, and the key free to be taken over, if
+// the key has gone from the store or the owner is a container on this host we are not managing.
+// Publishing the same content as the owner is not a conflict, though the owner is left as is.
+// Returns true if we should record ourselves as the owner, along with the index of the owner
+// record the claim must be made against, or an error if we must not publish
+//	owner:		the container publishing to the key
+//	key:		the key in the store
+//	value:		the value to be published
+func (r *ConfigHookService) checkOwner(owner *KeyOwner, key, value string) (bool, uint64, error) {
+	current, index, err := r.keyOwner(key)
+	if err != nil {
+		return false, 0, err
+	}
+	if current == nil || current.IsSame(owner) {
+		return true, index, nil
+	}
+	// step: is the owner stale?
+	found, err := r.store.Exists(key)
+	if err != nil {
+		return false, 0, err
+	}
+	if !found {
+		glog.V(3).Infof("The key: %s owned by %s has gone, taking ownership", key, current)
+		return true, index, nil
+	}
+	if r.isStaleOwner(current) {
+		glog.V(3).Infof("The key: %s owned by %s is no longer managed, taking ownership", key, current)
+		return true, index, nil
+	}
+	node, err := r.store.Get(key)
+	if err != nil {
+		return false, 0, err
+	}
+	if node != nil && !node.IsDir() && node.Value == value {
+		return false, index, nil
+	}
+	// step: we have a conflict
+	keyConflicts.Inc(config.Options.Conflict)
+	switch config.Options.Conflict {
+	case CONFLICT_LAST:
+		glog.Warningf("The key: %s owned by %s is being overwritten by %s", key, current, owner)
+		return true, index, nil
+	}
+	return false, 0, &KeyConflictErr{Key: key, Owner: current}
+}
+
+// Claims the ownership of the key before we publish to it. The owner record is only replaced if
+// it's unchanged since it was checked, so two containers can't both take the key; if it has
+// changed the ownership is checked again against the new owner
+//	owner:		the container publishing to the key
+//	key:		the key in the store
+//	value:		the value to be published
+//	ttl:		the ttl of the key, zero if the key does not expire
+func (r *ConfigHookService) claimOwner(owner *KeyOwner, key, value string, ttl time.Duration) error {
+	for attempt := 0; attempt < OWNER_CLAIM_ATTEMPTS; attempt++ {
+		claim, index, err := r.checkOwner(owner, key, value)
+		if err != nil || !claim {
+			return err
+		}
+		err = r.setOwner(owner, key, index, ttl)
+		if err != store.KeyModifiedErr {
+			return err
+		}
+		glog.V(3).Infof("The owner of key: %s changed while claiming it, checking again", key)
+	}
+	return fmt.Errorf("the owner of key: %s keeps changing, giving up the claim", key)
+}
+
+// Checks the ownership of all the keys of a hook before publishing any of them when refusing
+// conflicts, so a refused hook publishes nothing
+//	containerId:	the container holding the hook
+//	hook:			the type of hook
+//	name:			the name of the hook
+//	owner:			the container publishing the keys
+//	pairs:			the keys and values to be published
+func (r *ConfigHookService) checkOwners(containerId, hook, name string, owner *KeyOwner, pairs map[string]string) error {
+	if !isOwnerTracked() || config.Options.Conflict != CONFLICT_REFUSE {
+		return nil
+	}
+	for key, value := range pairs {
+		if _, _, err := r.checkOwner(owner, key, value); err != nil {
+			if _, conflict := err.(*KeyConflictErr); conflict {
+				r.refuseHook(containerId, hook, name, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Records the owner of a key if the record is unchanged since it was read; the record shares the
+// ttl of the key. Returns store.KeyModifiedErr if the record has changed
+//	owner:		the container publishing the key
+//	key:		the key in the store
+//	index:		the index of the owner record when read, zero if there was none
+//	ttl:		the ttl of the key, zero if the key does not expire
+func (r *ConfigHookService) setOwner(owner *KeyOwner, key string, index uint64, ttl time.Duration) error {
+	record := *owner
+	record.Updated = time.Now().UTC()
+	content, err := json.Marshal(&record)
+	if err != nil {
+		return err
+	}
+	return r.store.CompareAndSet(ownerKey(key), string(content), index, ttl)
+}
+
+// Checks if the key is owned by another container, in which case we leave it alone
+//	containerId:	the container which published the key
+//	key:			the key in the store
+func (r *ConfigHookService) isOwnedByOther(containerId, key string) (bool, error) {
+	if !isOwnerTracked() {
+		return false, nil
+	}
+	current, _, err := r.keyOwner(key)
+	if err != nil || current == nil {
+		return false, err
+	}
+	return !current.IsSame(&KeyOwner{Host: r.hostname, Container: containerId}), nil
+}
+
+// Checks if the key is owned by another container which is still live, i.e. the owner is not a
+// container on this host we are no longer managing
+//	containerId:	the container which published the key
+//	key:			the key in the store
+func (r *ConfigHookService) isOwnedByLive(containerId, key string) (bool, error) {
+	if !isOwnerTracked() {
+		return false, nil
+	}
+	current, _, err := r.keyOwner(key)
+	if err != nil || current == nil {
+		return false, err
+	}
+	if current.IsSame(&KeyOwner{Host: r.hostname, Container: containerId}) {
+		return false, nil
+	}
+	return !r.isStaleOwner(current), nil
+}
+
+// Releases the ownership of the keys published by a container which has been destroyed
+//	containerId:	the container which has been destroyed
+//	hooks:			the hooks for the container
+func (r *ConfigHookService) releaseOwners(containerId string, hooks *Hooks) {
+	if !isOwnerTracked() {
+		return
+	}
+	keys := make([]string, 0)
+	for _, file := range hooks.files {
+		for key, _ := range publishedContent(file.PublishedKeys()) {
+			keys = append(keys, key)
+		}
+	}
+	for _, published := range hooks.keys {
+		for key, _ := range published.Keys {
+			keys = append(keys, key)
+		}
+	}
+	owner := &KeyOwner{Host: r.hostname, Container: containerId}
+	for _, key := range keys {
+		if current, _, err := r.keyOwner(key); err != nil || current == nil || !current.IsSame(owner) {
+			continue
+		}
+		if err := r.store.Delete(ownerKey(key)); err != nil {
+			glog.Errorf("Failed to release the ownership of key: %s, container: %s, error: %s", key, containerId[:12], err)
+		}
+	}
+}
+
+// Refuses a hook which conflicts with the owner of a key, removing it from the container so
+// it's no longer published, and reporting it in the rejected hooks
+//	containerId:	the container holding the hook
+//	hook:			the type of hook
+//	name:			the name of the hook
+//	err:			the conflict with the owner
+func (r *ConfigHookService) refuseHook(containerId, hook, name string, err error) {
+	hooks, found := r.hooks[containerId]
+	if !found {
+		return
+	}
+	glog.Errorf("Refusing the hook: %s, container: %s, %s", name, containerId[:12], err)
+	switch hook {
+	case HOOK_FILE:
+		delete(hooks.files, name)
+	case HOOK_KEYS:
+		delete(hooks.keys, name)
+	}
+	hooks.Reject(hook, name, err)
+	hooksRejected.Inc(hook)
+}
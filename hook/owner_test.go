@@ -0,0 +1,271 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gambol99/config-hook/config"
+	"github.com/gambol99/config-hook/store"
+	"github.com/stretchr/testify/assert"
+)
+
+// creates a service with a container publishing the haproxy config, the key being owned by a
+// container on another host
+//	policy:		the conflict policy to apply
+func newTestConflict(t *testing.T, policy string) (*ConfigHookService, *fakeDocker) {
+	config.Options.Conflict = policy
+	service, docker := newTestService(t)
+	service.hostname = "host-a"
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":  "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_KEYS_SETTINGS": "/etc/settings;",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/haproxy.cfg": "haproxy config",
+		"/etc/settings":    "ONE=1\nTWO=2\n",
+	}
+	other := &KeyOwner{Host: "host-b", Container: TEST_CONTAINER_OTHER, Image: "haproxy:1.5", Hook: HOOK_FILE, Name: "HAPROXY"}
+	for key, value := range map[string]string{"/env/haproxy.cfg": "other config", "TWO": "two"} {
+		assert.Nil(t, service.store.Set(key, value))
+		assert.Nil(t, service.setOwner(other, key, 0, 0))
+	}
+	return service, docker
+}
+
+func TestParseConflictPolicy(t *testing.T) {
+	for _, policy := range []string{"first", "LAST", " refuse "} {
+		_, err := parseConflictPolicy(policy)
+		assert.Nil(t, err, "the policy: %s should be valid", policy)
+	}
+	_, err := parseConflictPolicy("mine")
+	assert.NotNil(t, err)
+	// step: the default keeps the last publish winning, as before the owners were tracked
+	policy, err := parseConflictPolicy(config.DEFAULT_CONFLICT)
+	assert.Nil(t, err)
+	assert.Equal(t, CONFLICT_LAST, policy)
+}
+
+func TestServiceOwnerRecorded(t *testing.T) {
+	service, docker := newTestService(t)
+	service.hostname = "host-a"
+	docker.images[TEST_CONTAINER] = "haproxy:1.5"
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	owner, _, err := service.keyOwner("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.NotNil(t, owner)
+	assert.Equal(t, "host-a", owner.Host)
+	assert.Equal(t, TEST_CONTAINER, owner.Container)
+	assert.Equal(t, "haproxy:1.5", owner.Image)
+	assert.Equal(t, HOOK_FILE, owner.Hook)
+	assert.Equal(t, "HAPROXY", owner.Name)
+	assert.False(t, owner.Updated.IsZero())
+
+	// step: the ownership is released when the container is destroyed
+	service.processContainerDestruction(TEST_CONTAINER)
+	owner, _, err = service.keyOwner("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Nil(t, owner)
+}
+
+func TestServiceConflictLast(t *testing.T) {
+	defer func() { config.Options.Conflict = config.DEFAULT_CONFLICT }()
+	service, _ := newTestConflict(t, CONFLICT_LAST)
+	service.processContainerCreation(TEST_CONTAINER)
+
+	for key, value := range map[string]string{"/env/haproxy.cfg": "haproxy config", "TWO": "2"} {
+		node, err := service.store.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, value, node.Value)
+		owner, _, err := service.keyOwner(key)
+		assert.Nil(t, err)
+		assert.Equal(t, TEST_CONTAINER, owner.Container)
+	}
+}
+
+func TestServiceConflictFirst(t *testing.T) {
+	defer func() { config.Options.Conflict = config.DEFAULT_CONFLICT }()
+	service, _ := newTestConflict(t, CONFLICT_FIRST)
+	service.processContainerCreation(TEST_CONTAINER)
+
+	// step: the keys owned by the other container are left alone
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "other config", node.Value)
+	file := service.hooks[TEST_CONTAINER].files["HAPROXY"]
+	assert.Contains(t, file.LastError, "host-b")
+	keys := service.hooks[TEST_CONTAINER].keys["SETTINGS"]
+	assert.NotEmpty(t, keys.LastError)
+	node, err = service.store.Get("ONE")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", node.Value)
+	node, err = service.store.Get("TWO")
+	assert.Nil(t, err)
+	assert.Equal(t, "two", node.Value)
+
+	// step: once the key has gone the owner is stale and the reconcile takes over
+	assert.Nil(t, service.store.Delete("/env/haproxy.cfg"))
+	service.reconcile()
+	node, err = service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+	owner, _, err := service.keyOwner("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, TEST_CONTAINER, owner.Container)
+}
+
+func TestServiceConflictRefuse(t *testing.T) {
+	defer func() { config.Options.Conflict = config.DEFAULT_CONFLICT }()
+	service, _ := newTestConflict(t, CONFLICT_REFUSE)
+	service.processContainerCreation(TEST_CONTAINER)
+
+	// step: both hooks are refused and nothing is published
+	hooks := service.hooks[TEST_CONTAINER]
+	assert.Empty(t, hooks.files)
+	assert.Empty(t, hooks.keys)
+	assert.Equal(t, 2, len(hooks.rejected))
+	found, err := service.store.Exists("ONE")
+	assert.Nil(t, err)
+	assert.False(t, found)
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "other config", node.Value)
+}
+
+func TestServiceConflictStaleOwner(t *testing.T) {
+	defer func() { config.Options.Conflict = config.DEFAULT_CONFLICT }()
+	service, docker := newTestConflict(t, CONFLICT_REFUSE)
+	// step: a container on this host we are not managing no longer owns the key
+	_, index, err := service.keyOwner("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Nil(t, service.setOwner(&KeyOwner{Host: "host-a", Container: TEST_CONTAINER_OTHER}, "/env/haproxy.cfg", index, 0))
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+	assert.False(t, service.hooks[TEST_CONTAINER].HasRejected())
+	// step: the owner record can't be replaced without the index it was read at
+	assert.Equal(t, store.KeyModifiedErr, service.setOwner(&KeyOwner{Host: "host-b", Container: TEST_CONTAINER_OTHER}, "/env/haproxy.cfg", 0, 0))
+	assert.Equal(t, store.KeyModifiedErr, service.setOwner(&KeyOwner{Host: "host-b", Container: TEST_CONTAINER_OTHER}, "/env/haproxy.cfg", index, 0))
+}
+
+func TestServiceConflictSameContent(t *testing.T) {
+	defer func() { config.Options.Conflict = config.DEFAULT_CONFLICT }()
+	service, docker := newTestConflict(t, CONFLICT_REFUSE)
+	docker.files[TEST_CONTAINER]["/etc/haproxy.cfg"] = "other config"
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	// step: publishing the same content is not a conflict, but the owner is unchanged
+	file := service.hooks[TEST_CONTAINER].files["HAPROXY"]
+	assert.True(t, file.IsPublished())
+	owner, _, err := service.keyOwner("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, TEST_CONTAINER_OTHER, owner.Container)
+
+	// step: nor do we cleanup or release a key owned by another container
+	file.Cleanup = CLEANUP_DELETE
+	service.processContainerDestruction(TEST_CONTAINER)
+	found, err := service.store.Exists("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	owner, _, err = service.keyOwner("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, TEST_CONTAINER_OTHER, owner.Container)
+}
+
+// a store which runs a change before the compare and set, emulating another agent claiming a key
+type claimingStore struct {
+	store.Store
+	// the change made before the first compare and set
+	change func()
+}
+
+func (r *claimingStore) CompareAndSet(key string, value string, index uint64, ttl time.Duration) error {
+	if r.change != nil {
+		change := r.change
+		r.change = nil
+		change()
+	}
+	return r.Store.CompareAndSet(key, value, index, ttl)
+}
+
+func TestServiceConflictClaimed(t *testing.T) {
+	defer func() { config.Options.Conflict = config.DEFAULT_CONFLICT }()
+	service, docker := newTestConflict(t, CONFLICT_FIRST)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_NGINX": "/etc/haproxy.cfg;/env/nginx.cfg",
+	}
+	// step: another container claims the key between our check and claim of the owner
+	other := &KeyOwner{Host: "host-b", Container: TEST_CONTAINER_OTHER}
+	claiming := &claimingStore{Store: service.store}
+	claiming.change = func() {
+		assert.Nil(t, service.setOwner(other, "/env/nginx.cfg", 0, 0))
+		assert.Nil(t, claiming.Store.Set("/env/nginx.cfg", "other config"))
+	}
+	service.store = claiming
+	service.processContainerCreation(TEST_CONTAINER)
+
+	// step: the claim fails and the key is left with the other container
+	node, err := service.store.Get("/env/nginx.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "other config", node.Value)
+	owner, _, err := service.keyOwner("/env/nginx.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, TEST_CONTAINER_OTHER, owner.Container)
+	assert.Contains(t, service.hooks[TEST_CONTAINER].files["NGINX"].LastError, "host-b")
+}
+
+func TestServiceReconcileOwnedByOther(t *testing.T) {
+	defer func() { config.Options.Conflict = config.DEFAULT_CONFLICT }()
+	service, docker := newTestConflict(t, CONFLICT_LAST)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+
+	// step: the other container takes the key back, the reconcile must leave it be
+	_, index, err := service.keyOwner("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Nil(t, service.setOwner(&KeyOwner{Host: "host-b", Container: TEST_CONTAINER_OTHER}, "/env/haproxy.cfg", index, 0))
+	assert.Nil(t, service.store.Set("/env/haproxy.cfg", "other config"))
+	service.reconcile()
+	node, err = service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "other config", node.Value)
+
+	// step: once the owner is a container on this host we no longer manage, the key is ours again
+	_, index, err = service.keyOwner("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Nil(t, service.setOwner(&KeyOwner{Host: "host-a", Container: TEST_CONTAINER_OTHER}, "/env/haproxy.cfg", index, 0))
+	service.reconcile()
+	node, err = service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+}
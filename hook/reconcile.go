@@ -0,0 +1,136 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"github.com/golang/glog"
+)
+
+// Brings the managed hooks back in sync with the containers and the store; any running
+// containers we missed are processed, those which have been removed are destroyed and any keys
+// which no longer hold the published content are republished. A container which has stopped
+// but not been removed keeps its keys, as it may yet be started again
+func (r *ConfigHookService) reconcile() {
+	glog.V(5).Infof("Reconciling the running containers with the managed hooks")
+	reconcileRuns.Inc()
+	containers, err := r.docker.List(true)
+	if err != nil {
+		glog.Errorf("Failed to list the containers for the reconcile, error: %s", err)
+		return
+	}
+	existing := make(map[string]bool, 0)
+	for _, containerId := range containers {
+		existing[containerId] = true
+	}
+	containers, err = r.docker.List(false)
+	if err != nil {
+		glog.Errorf("Failed to list the running containers for the reconcile, error: %s", err)
+		return
+	}
+	running := make(map[string]bool, 0)
+	for _, containerId := range containers {
+		running[containerId] = true
+	}
+
+	// step: find the containers we have missed and those which have been removed
+	r.RLock()
+	missed := make([]string, 0)
+	for containerId, _ := range running {
+		if _, found := r.hooks[containerId]; !found && !r.ignored[containerId] {
+			missed = append(missed, containerId)
+		}
+	}
+	gone := make([]string, 0)
+	for containerId, _ := range r.hooks {
+		if !existing[containerId] {
+			gone = append(gone, containerId)
+		}
+	}
+	for containerId, _ := range r.ignored {
+		if !existing[containerId] {
+			gone = append(gone, containerId)
+		}
+	}
+	r.RUnlock()
+
+	for _, containerId := range missed {
+		glog.Infof("Reconcile found the container: %s which was not being processed", containerId[:12])
+		reconcileRepairs.Inc("created")
+		r.processContainerCreation(containerId)
+	}
+	for _, containerId := range gone {
+		glog.Infof("Reconcile found the container: %s has been removed", containerId[:12])
+		reconcileRepairs.Inc("destroyed")
+		r.processContainerDestruction(containerId)
+	}
+
+	// step: verify the published content of the running containers is still in the store
+	r.Lock()
+	defer r.Unlock()
+	for containerId, hooks := range r.hooks {
+		if !running[containerId] {
+			continue
+		}
+		for _, file := range hooks.files {
+			if r.isPublished(containerId, file.IsPublished(), file.Flags.IsOneTime(), file.PublishedKeys()) {
+				continue
+			}
+			glog.Infof("Reconcile found the key: %s of file: %s, container: %s out of sync, republishing", file.Key, file.File, containerId[:12])
+			reconcileRepairs.Inc("republished")
+			if err := r.publishFile(containerId, file); err != nil {
+				glog.Errorf("Failed to republish the hook file: %s, container: %s, error: %s", file.ID, containerId[:12], err)
+			}
+		}
+		for _, keys := range hooks.keys {
+			if r.isPublished(containerId, keys.IsPublished(), keys.Flags.IsOneTime(), keys.Keys) {
+				continue
+			}
+			glog.Infof("Reconcile found the keys from file: %s, container: %s out of sync, republishing", keys.File, containerId[:12])
+			reconcileRepairs.Inc("republished")
+			if err := r.publishKeys(containerId, keys); err != nil {
+				glog.Errorf("Failed to republish the hook keys: %s, container: %s, error: %s", keys.ID, containerId[:12], err)
+			}
+		}
+	}
+}
+
+// Checks the last publish was successful and the keys still hold the published content; a one
+// time hook never overwrites a key, so we only check they still exist. A key taken over by
+// another live container is left with it, else two containers would overwrite each other
+//	containerId:	the container holding the hook
+//	published:		the last publish of the hook was successful
+//	onetime:		the hook is a one time hook
+//	keys:			a map of the keys and the checksum of the content published
+func (r *ConfigHookService) isPublished(containerId string, published, onetime bool, keys map[string]string) bool {
+	if !published {
+		return false
+	}
+	for key, checksum := range keys {
+		if other, err := r.isOwnedByLive(containerId, key); err == nil && other {
+			glog.V(4).Infof("The key: %s is owned by another container, skipping it, container: %s", key, shortID(containerId))
+			continue
+		}
+		if onetime || checksum == "" {
+			if found, err := r.store.Exists(key); err != nil || !found {
+				return false
+			}
+			continue
+		}
+		node, err := r.store.Get(key)
+		if err != nil || node == nil || getChecksum(node.Value) != checksum {
+			return false
+		}
+	}
+	return true
+}
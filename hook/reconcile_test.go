@@ -0,0 +1,110 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	TEST_CONTAINER_OTHER = "fedcba9876543210fedcba9876543210"
+)
+
+func TestReconcileContainers(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg",
+	}
+	docker.environment[TEST_CONTAINER_OTHER] = map[string]string{"PATH": "/bin"}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+
+	// step: the containers were missed, i.e. a dropped event
+	service.reconcile()
+	assert.NotNil(t, service.hooks[TEST_CONTAINER])
+	assert.True(t, service.ignored[TEST_CONTAINER_OTHER])
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+
+	// step: the containers have gone
+	delete(docker.environment, TEST_CONTAINER)
+	delete(docker.environment, TEST_CONTAINER_OTHER)
+	service.reconcile()
+	assert.Nil(t, service.hooks[TEST_CONTAINER])
+	assert.False(t, service.ignored[TEST_CONTAINER_OTHER])
+}
+
+func TestReconcileStoppedContainer(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":         "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_FILE_HAPROXY_CLEANUP": "delete",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	// step: a stopped container still exists, so it keeps its hooks, keys and ownership
+	docker.stopped[TEST_CONTAINER] = true
+	service.processContainerStopped(TEST_CONTAINER)
+	service.reconcile()
+	assert.NotNil(t, service.hooks[TEST_CONTAINER])
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+	owner, _, err := service.keyOwner("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.NotNil(t, owner)
+	assert.Equal(t, TEST_CONTAINER, owner.Container)
+
+	// step: once removed the cleanup is applied
+	delete(docker.environment, TEST_CONTAINER)
+	service.reconcile()
+	assert.Nil(t, service.hooks[TEST_CONTAINER])
+	found, err := service.store.Exists("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
+
+func TestReconcileKeys(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":  "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_FILE_NGINX":    "/etc/nginx.cfg;/env/nginx.cfg;;;OT",
+		"CONFIG_HOOK_KEYS_SETTINGS": "/etc/settings",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/haproxy.cfg": "haproxy config",
+		"/etc/nginx.cfg":   "nginx config",
+		"/etc/settings":    "ONE=1\nTWO=2\n",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	// step: the keys have been changed or removed behind our back
+	assert.Nil(t, service.store.Set("/env/haproxy.cfg", "tampered"))
+	assert.Nil(t, service.store.Set("/env/nginx.cfg", "changed by hand"))
+	assert.Nil(t, service.store.Delete("TWO"))
+	service.reconcile()
+
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+	node, err = service.store.Get("/env/nginx.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "changed by hand", node.Value)
+	node, err = service.store.Get("TWO")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", node.Value)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"time"
+
+	"github.com/gambol99/config-hook/config"
+
+	"github.com/golang/glog"
+)
+
+// A notification the content of a hook file inside a container has changed
+type ContentChange struct {
+	// the container the file resides in
+	ContainerID string
+	// the type of hook, FILE or KEYS
+	Hook string
+	// the name of the hook
+	Name string
+	// the checksum of the content read from the container
+	Checksum string
+}
+
+type ContentChannel chan ContentChange
+
+// Retrieves the refresh interval for a hook, falling back to the agent interval if not set
+//	interval:	the refresh interval of the hook
+func refreshInterval(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return config.Options.Interval
+	}
+	return interval
+}
+
+// Starts the periodic re-reads of the hook files in a container
+//	containerId:	the container holding the files
+//	hooks:			the hooks for the container
+func (r *ConfigHookService) refreshHooks(containerId string, hooks *Hooks) {
+	for name, file := range hooks.files {
+		if interval := refreshInterval(file.Interval); interval > 0 {
+			go r.refreshFile(containerId, HOOK_FILE, name, file.File, interval, hooks.shutdown)
+		}
+	}
+	for name, keys := range hooks.keys {
+		if interval := refreshInterval(keys.Interval); interval > 0 {
+			go r.refreshFile(containerId, HOOK_KEYS, name, keys.File, interval, hooks.shutdown)
+		}
+	}
+}
+
+// Periodically reads a file from the container and sends a notification with the checksum
+// of the content, until the hooks for the container are closed
+//	containerId:	the container holding the file
+//	hook:			the type of hook
+//	name:			the name of the hook
+//	filename:		the path of the file inside the container
+//	interval:		the interval between reads
+//	shutdown:		the channel closed when the container is gone
+func (r *ConfigHookService) refreshFile(containerId, hook, name, filename string, interval time.Duration, shutdown ShutdownChannel) {
+	glog.V(5).Infof("Refreshing the file: %s, container: %s every %s", filename, containerId[:12], interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			checksum, err := r.readChecksum(containerId, filename)
+			if err != nil {
+				glog.Errorf("Failed to refresh the file: %s, container: %s, error: %s", filename, containerId[:12], err)
+				continue
+			}
+			select {
+			case r.content_changes <- ContentChange{
+				ContainerID: containerId,
+				Hook:        hook,
+				Name:        name,
+				Checksum:    checksum,
+			}:
+			case <-shutdown:
+				return
+			}
+		case <-shutdown:
+			glog.V(5).Infof("Stopping the refresh of file: %s, container: %s", filename, containerId[:12])
+			return
+		}
+	}
+}
+
+// Reads a file, or the files beneath a directory, from the container and computes the checksum
+// of the content
+//	containerId:	the container holding the file
+//	filename:		the path of the file inside the container
+func (r *ConfigHookService) readChecksum(containerId, filename string) (string, error) {
+	content, err := r.docker.GetFile(containerId, filename)
+	if err == DirectoryErr {
+		files, err := r.docker.GetDirectory(containerId, filename)
+		if err != nil {
+			return "", err
+		}
+		return getTreeChecksum(files), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return getChecksum(content), nil
+}
+
+// Republishes the hook if the content of the file has changed since the last publish
+//	change:		the notification from the refresh
+func (r *ConfigHookService) processContentChange(change ContentChange) {
+	r.Lock()
+	defer r.Unlock()
+	hooks, found := r.hooks[change.ContainerID]
+	if !found {
+		return
+	}
+	switch change.Hook {
+	case HOOK_FILE:
+		if file, found := hooks.files[change.Name]; found && file.Checksum != change.Checksum {
+			glog.V(3).Infof("The file: %s has changed in container: %s, republishing", file.File, change.ContainerID[:12])
+			if err := r.publishFile(change.ContainerID, file); err != nil {
+				glog.Errorf("Failed to republish the hook file: %s, container: %s, error: %s", file.ID, change.ContainerID[:12], err)
+			}
+		}
+	case HOOK_KEYS:
+		if keys, found := hooks.keys[change.Name]; found && keys.Checksum != change.Checksum {
+			glog.V(3).Infof("The keys file: %s has changed in container: %s, republishing", keys.File, change.ContainerID[:12])
+			if err := r.publishKeys(change.ContainerID, keys); err != nil {
+				glog.Errorf("Failed to republish the hook keys: %s, container: %s, error: %s", keys.ID, change.ContainerID[:12], err)
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gambol99/config-hook/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshInterval(t *testing.T) {
+	assert.Equal(t, config.Options.Interval, refreshInterval(0))
+	assert.Equal(t, 10*time.Second, refreshInterval(10*time.Second))
+}
+
+func TestHookIntervalSet(t *testing.T) {
+	file := NewHookFile("test")
+	assert.Nil(t, file.Set("INTERVAL", "30s"))
+	assert.Equal(t, 30*time.Second, file.Interval)
+	assert.NotNil(t, file.Set("INTERVAL", "-1s"))
+	assert.NotNil(t, file.Set("INTERVAL", "soon"))
+
+	keys := NewHookKeys("test")
+	assert.Nil(t, keys.Set("INTERVAL", "1m"))
+	assert.Equal(t, time.Minute, keys.Interval)
+}
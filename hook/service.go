@@ -15,12 +15,19 @@ package hook
 
 import (
 	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path"
 	"regexp"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/gambol99/config-hook/config"
+	"github.com/gambol99/config-hook/metrics"
 	"github.com/gambol99/config-hook/store"
 
-	"github.com/go-fsnotify/fsnotify"
 	"github.com/golang/glog"
 )
 
@@ -30,6 +37,8 @@ type ConfigHook interface {
 }
 
 type ConfigHookService struct {
+	// a lock for the hooks map
+	sync.RWMutex
 	// the agent for the k/v store
 	store store.Store
 	// the docker client
@@ -40,8 +49,20 @@ type ConfigHookService struct {
 	update_channel store.NodeUpdateChannel
 	// a map of containerId to config hooks
 	hooks map[string]*Hooks
-	// the file watcher
-	inotify *fsnotify.Watcher
+	// channel used to receive the checksums of hook files re-read from the containers
+	content_changes ContentChannel
+	// the listener for the status api
+	listener net.Listener
+	// the name of the store backend, i.e. etcd
+	backend string
+	// the hostname of the agent, recorded in the owners of the keys
+	hostname string
+	// the containers which have been checked and have no hooks
+	ignored map[string]bool
+	// closed when the event processor has drained the queues and exited
+	stopped ShutdownChannel
+	// the execs presently running in the containers
+	execs sync.WaitGroup
 }
 
 const (
@@ -52,25 +73,64 @@ const (
 var (
 	hook_file_regex, hook_keys_regex   *regexp.Regexp
 	hook_file_prefix, hook_keys_prefix string
+	hook_label_regex                   *regexp.Regexp
+	hook_label_prefix                  string
 )
 
+// Sets the prefixes and regexes used to identify the hooks in the container
+//	prefix:		the runtime prefix for the hooks
+func setHookPrefix(prefix string) {
+	hook_file_regex = regexp.MustCompile(fmt.Sprintf("^%s%s_([[:alpha:]]+)(?:_(KEY|CHECK|EXEC|FLAGS|CLEANUP|INTERVAL|TTL))?$",
+		prefix, HOOK_FILE))
+	hook_keys_regex = regexp.MustCompile(fmt.Sprintf("^%s%s_([[:alpha:]]+)(?:_(FLAGS|CLEANUP|INTERVAL|TTL|FORMAT|KEY|MAPPING))?$",
+		prefix, HOOK_KEYS))
+	hook_file_prefix = fmt.Sprintf("%s%s", prefix, HOOK_FILE)
+	hook_keys_prefix = fmt.Sprintf("%s%s", prefix, HOOK_KEYS)
+}
+
+// Sets the prefix and regex used to identify the hooks in the image and container labels
+//	prefix:		the label prefix for the hooks, i.e. config-hook
+func setLabelPrefix(prefix string) {
+	hook_label_regex = regexp.MustCompile(fmt.Sprintf(`^%s\.(file|keys)\.([[:alpha:]]+)(?:\.(path|key|exec|check|flags|cleanup|interval|ttl|format|mapping))?$`,
+		regexp.QuoteMeta(prefix)))
+	hook_label_prefix = prefix + "."
+}
+
 func NewConfigHook() (ConfigHook, error) {
 
 	var err error
 	service := new(ConfigHookService)
 	service.update_channel = make(store.NodeUpdateChannel, 10)
 	service.hooks = make(map[string]*Hooks, 0)
+	service.ignored = make(map[string]bool, 0)
 	service.shutdown = make(ShutdownChannel)
+	service.stopped = make(ShutdownChannel)
+	service.content_changes = make(ContentChannel, 10)
 
 	// step: set the prefixes and regexes
-	hook_file_regex = regexp.MustCompile(fmt.Sprintf("^%s%s_([[:alpha:]]+)[$_]?(KEY|CHECK|EXEC|FLAGS)?",
-		config.Options.Runtime_Prefix, HOOK_FILE))
-	hook_keys_regex = regexp.MustCompile(fmt.Sprintf("^%s%s_([[:alpha:]]+)$",
-		config.Options.Runtime_Prefix, HOOK_KEYS))
-	hook_file_prefix = fmt.Sprintf("%s%s", config.Options.Runtime_Prefix, HOOK_FILE)
-	hook_keys_prefix = fmt.Sprintf("%s%s", config.Options.Runtime_Prefix, HOOK_KEYS)
+	setHookPrefix(config.Options.Runtime_Prefix)
+	setLabelPrefix(config.Options.Label_Prefix)
+
+	// step: validate the agent cleanup policy
+	if config.Options.Cleanup, err = parseCleanupPolicy(config.Options.Cleanup); err != nil {
+		glog.Errorf("Invalid cleanup policy, error: %s", err)
+		return nil, err
+	}
+
+	// step: validate the conflict policy
+	if config.Options.Conflict, err = parseConflictPolicy(config.Options.Conflict); err != nil {
+		glog.Errorf("Invalid conflict policy, error: %s", err)
+		return nil, err
+	}
+	if service.hostname, err = os.Hostname(); err != nil {
+		glog.Errorf("Failed to retrieve the hostname, error: %s", err)
+		return nil, err
+	}
 
 	// step: we need to create a store agent
+	if location, err := url.Parse(config.Options.Store_URL); err == nil {
+		service.backend = location.Scheme
+	}
 	service.store, err = store.NewStore(config.Options.Store_URL, service.update_channel)
 	if err != nil {
 		glog.Errorf("Failed to create a store agent, url: %s, error: %s", config.Options.Store_URL, err)
@@ -92,6 +152,14 @@ func NewConfigHook() (ConfigHook, error) {
 		return nil, err
 	}
 
+	// step: start the status api if required
+	if config.Options.Listen != "" {
+		if err := service.startAPI(config.Options.Listen); err != nil {
+			glog.Errorf("Failed to start the status api on: %s, error: %s", config.Options.Listen, err)
+			return nil, err
+		}
+	}
+
 	// step: kick off the processing of events
 	if err := service.processEvents(); err != nil {
 		glog.Errorf("Failed to start processing events in the Hook Service, error: %s", err)
@@ -101,13 +169,57 @@ func NewConfigHook() (ConfigHook, error) {
 	return service, nil
 }
 
+// Shuts down the service; we stop accepting docker events, drain any queued work and wait for
+// the running execs, up to the shutdown timeout, before closing the store
 func (r *ConfigHookService) Close() {
 	glog.Infof("Shutting down the %s", config.NAME)
+	timeout := time.After(config.Options.Shutdown_Timeout)
+	// step: stop accepting docker events and requests to the status api
+	r.docker.Close()
+	if r.listener != nil {
+		r.listener.Close()
+	}
+	// step: wait for the event processor to drain the queued events
+	close(r.shutdown)
+	if !waitFor(r.stopped, timeout) {
+		glog.Warningf("Timed out waiting for the queued events to be processed")
+	}
+	// step: stop refreshing the hook files
+	r.Lock()
+	for _, hooks := range r.hooks {
+		hooks.Stop()
+	}
+	r.hooks = make(map[string]*Hooks, 0)
+	r.Unlock()
+	// step: wait for any running execs to finish
+	execs := make(ShutdownChannel)
+	go func() {
+		r.execs.Wait()
+		close(execs)
+	}()
+	if !waitFor(execs, timeout) {
+		glog.Warningf("Timed out waiting for the running execs to finish, abandoning them")
+	}
+	// step: close the store, stopping any watches
+	r.store.Close()
+	glog.Infof("Shutdown of the %s complete", config.NAME)
+}
+
+// Waits for the channel to be closed or the timeout, returning false on a timeout
+//	done:		the channel closed when complete
+//	timeout:	the channel signalled on the timeout
+func waitFor(done ShutdownChannel, timeout <-chan time.Time) bool {
+	select {
+	case <-done:
+		return true
+	case <-timeout:
+		return false
+	}
 }
 
 func (r *ConfigHookService) preprocessContainers() error {
 	glog.V(6).Infof("Preprocessing any container which are already running")
-	containers, err := r.docker.List()
+	containers, err := r.docker.List(false)
 	if err != nil {
 		return err
 	}
@@ -121,70 +233,511 @@ func (r *ConfigHookService) preprocessContainers() error {
 func (r *ConfigHookService) processEvents() error {
 	// docker creation events
 	container_created := make(DockerEvent, 10)
+	container_stopped := make(DockerEvent, 10)
 	container_destroyed := make(DockerEvent, 10)
-	content_changes := make(chan string, 0)
 
 	// step: add the watch
 	r.docker.Watch(container_created, DOCKER_START)
+	r.docker.Watch(container_stopped, DOCKER_DIE)
+	r.docker.Watch(container_stopped, DOCKER_STOP)
 	r.docker.Watch(container_destroyed, DOCKER_DESTROY)
 
+	// step: expose the depth of the queues
+	metrics.DefaultRegistry.OnCollect(func() {
+		queueDepth.Set(float64(len(container_created)), "container_created")
+		queueDepth.Set(float64(len(container_stopped)), "container_stopped")
+		queueDepth.Set(float64(len(container_destroyed)), "container_destroyed")
+		queueDepth.Set(float64(len(r.update_channel)), "update_channel")
+		queueDepth.Set(float64(len(r.content_changes)), "content_changes")
+	})
+
 	go func() {
 		glog.Infof("Starting the event processor for config hook service")
+		// step: the periodic reconcile, a nil channel never fires if disabled
+		var reconcile <-chan time.Time
+		if config.Options.Reconcile > 0 {
+			ticker := time.NewTicker(config.Options.Reconcile)
+			defer ticker.Stop()
+			reconcile = ticker.C
+		}
 		for {
 			select {
 			// a container has been created
 			case id := <-container_created:
 				glog.V(6).Infof("Container: %s creation event", id)
 				r.processContainerCreation(id)
+			// a container has exited or been stopped
+			case id := <-container_stopped:
+				glog.V(6).Infof("Container: %s stopped event", id)
+				r.processContainerStopped(id)
 			// a container has been destroyed
 			case id := <-container_destroyed:
 				glog.V(6).Infof("Container: %s destruction event", id)
 				r.processContainerDestruction(id)
-			// the contents of a file has changed
-			case filename := <-content_changes:
-				glog.V(6).Infof("The file: %s has changed", filename)
+			// a key we are watching in the store has changed
+			case event := <-r.update_channel:
+				glog.V(6).Infof("The key: %s has changed in the store", event.Node.Path)
+				r.processNodeChange(event)
+			// a hook file has been re-read from a container
+			case change := <-r.content_changes:
+				glog.V(10).Infof("Refreshed the hook: %s, container: %s", change.Name, change.ContainerID[:12])
+				r.processContentChange(change)
+			// time to bring the containers and hooks back in sync
+			case <-reconcile:
+				r.reconcile()
 			// we have hit a shutdown event
 			case <-r.shutdown:
-				glog.Infof("Request to shutdown the service")
-				r.docker.Close()
+				glog.Infof("Request to shutdown the service, draining the queued events")
+				r.drainEvents(container_created, container_stopped, container_destroyed)
+				close(r.stopped)
+				return
 			}
 		}
 	}()
 	return nil
 }
 
+// Processes any events still queued, returning once all the queues are empty
+//	container_created:		the queue of container creation events
+//	container_stopped:		the queue of container stop events
+//	container_destroyed:	the queue of container destruction events
+func (r *ConfigHookService) drainEvents(container_created, container_stopped, container_destroyed DockerEvent) {
+	for {
+		select {
+		case id := <-container_created:
+			r.processContainerCreation(id)
+		case id := <-container_stopped:
+			r.processContainerStopped(id)
+		case id := <-container_destroyed:
+			r.processContainerDestruction(id)
+		case event := <-r.update_channel:
+			r.processNodeChange(event)
+		case change := <-r.content_changes:
+			r.processContentChange(change)
+		default:
+			return
+		}
+	}
+}
+
 func (r *ConfigHookService) processContainerCreation(containerId string) {
 	glog.V(5).Infof("Processing creation of container: %s", containerId[:12])
+	r.Lock()
+	defer r.Unlock()
+	containersProcessed.Inc()
 
 	// step: check if the container has any config hooks
 	hooks, has_hooks, err := r.hasConfig(containerId)
-	glog.V(10).Infof("Container: %s, hooks files: %V", containerId[:12], hooks.files)
 	if err != nil {
 		glog.Errorf("Failed to process the container: %s, error: %s", containerId[:12], err)
 		return
 	}
-	if !has_hooks {
+	glog.V(10).Infof("Container: %s, hooks files: %v", containerId[:12], hooks.files)
+	if !has_hooks && !hooks.HasRejected() {
 		glog.V(6).Infof("The container: %s has not config hooks, skipping", containerId[:12])
+		r.ignored[containerId] = true
 		return
 	}
 
+	// step: the container may already be managed, i.e. it was restarted or the event replayed, so
+	// we stop the refreshes and keepalives of the former hooks before replacing them
+	if former, found := r.hooks[containerId]; found {
+		glog.V(3).Infof("The container: %s is already managed, replacing its hooks", containerId[:12])
+		former.Stop()
+	}
 	// step: add the hooks map
 	r.hooks[containerId] = hooks
 
 	// step: process the hook files
-	for _, _ = range hooks.files {
+	for _, file := range hooks.files {
+		if err := r.publishFile(containerId, file); err != nil {
+			glog.Errorf("Failed to publish the hook file: %s, container: %s, error: %s", file.ID, containerId[:12], err)
+		}
+	}
+	// step: process the hook keys
+	for _, keys := range hooks.keys {
+		if err := r.publishKeys(containerId, keys); err != nil {
+			glog.Errorf("Failed to publish the hook keys: %s, container: %s, error: %s", keys.ID, containerId[:12], err)
+		}
+	}
+	// step: start checking the files for changes
+	r.refreshHooks(containerId, hooks)
+	// step: start keeping alive any keys published with a ttl
+	r.keepAliveHooks(containerId, hooks)
+}
+
+// Extracts the content of the hook file from the container and pushes into the store
+//	containerId:	the container id which holds the file
+//	file:			the hook file to be published
+func (r *ConfigHookService) publishFile(containerId string, file *HookFile) (err error) {
+	// step: record the outcome of the publish on the way out
+	defer func() {
+		file.Published(err)
+	}()
+	glog.V(5).Infof("Publishing the file: %s from container: %s to key: %s", file.File, containerId[:12], file.Key)
+	// step: a one time hook is never overwritten, nor is the exec run again
+	if file.Flags.IsOneTime() {
+		found, err := r.store.Exists(file.Key)
+		if err != nil {
+			return err
+		}
+		if found {
+			glog.V(3).Infof("The one time hook: %s, key: %s already exists, skipping", file.ID, file.Key)
+			return nil
+		}
+	}
+	// step: grab the content from the container
+	content, err := r.docker.GetFile(containerId, file.File)
+	if err == DirectoryErr {
+		return r.publishDirectory(containerId, file)
+	}
+	if err != nil {
+		return err
+	}
+	// step: push the content into the store, binary content is encoded
+	value, metadata := encodeContent(content, file.Flags.IsBinary())
+	if err = r.setKey(r.newOwner(containerId, HOOK_FILE, file.ID), file.Key, value, keyTTL(file.TTL)); err != nil {
+		if _, conflict := err.(*KeyConflictErr); conflict && config.Options.Conflict == CONFLICT_REFUSE {
+			r.refuseHook(containerId, HOOK_FILE, file.ID, err)
+		}
+		return err
+	}
+	for key, _ := range file.Keys {
+		if key != file.Key {
+			delete(file.Keys, key)
+		}
+	}
+	r.recordContent(file, file.Key, value, metadata)
+	file.Checksum = getChecksum(content)
+	glog.V(3).Infof("Published the file: %s from container: %s to key: %s", file.File, containerId[:12], file.Key)
+	// step: if the file has a exec, we need to watch the keys for changes
+	r.watchKeys(file)
+	return nil
+}
+
+// Publishes every file beneath a directory in the container, each under the key of the hook
+// joined with the path of the file relative to the directory
+//	containerId:	the container id which holds the directory
+//	file:			the hook file to be published
+func (r *ConfigHookService) publishDirectory(containerId string, file *HookFile) error {
+	glog.V(5).Infof("The path: %s in container: %s is a directory, publishing the tree under: %s", file.File, containerId[:12], file.Key)
+	files, err := r.docker.GetDirectory(containerId, file.File)
+	if err != nil {
+		return err
+	}
+	pairs := make(map[string]string, 0)
+	encoded := make(map[string]*KeyMetadata, 0)
+	for name, content := range files {
+		key := path.Join(file.Key, name)
+		pairs[key], encoded[key] = encodeContent(content, file.Flags.IsBinary())
+	}
+	// step: push each of the files into the store
+	owner := r.newOwner(containerId, HOOK_FILE, file.ID)
+	if err := r.checkOwners(containerId, HOOK_FILE, file.ID, owner, pairs); err != nil {
+		return err
+	}
+	failed := 0
+	for key, value := range pairs {
+		if err := r.setKey(owner, key, value, keyTTL(file.TTL)); err != nil {
+			glog.Errorf("Failed to set the key: %s from directory: %s, container: %s, error: %s", key, file.File, containerId[:12], err)
+			failed++
+			continue
+		}
+		r.recordContent(file, key, value, encoded[key])
+	}
+	// step: with a ttl the keys no longer in the directory are left to expire, so we stop tracking them
+	if keyTTL(file.TTL) > 0 {
+		for key, _ := range file.Keys {
+			if _, found := pairs[key]; !found {
+				delete(file.Keys, key)
+				delete(file.Encoded, key)
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to set %d of %d files from the directory: %s", failed, len(pairs), file.File)
+	}
+	file.Checksum = getTreeChecksum(files)
+	glog.V(3).Infof("Published %d files from the directory: %s, container: %s under key: %s", len(pairs), file.File, containerId[:12], file.Key)
+	// step: if the file has a exec, we need to watch the keys for changes
+	r.watchKeys(file)
+	return nil
+}
+
+// Watches the keys published by the hook file for changes if it has an exec
+//	file:		the hook file which has been published
+func (r *ConfigHookService) watchKeys(file *HookFile) {
+	if !file.Exec.HasExec() {
+		return
+	}
+	for key, _ := range file.PublishedKeys() {
+		r.store.Watch(key)
+	}
+}
 
+// Sets the key in the store, checking and recording the owner and keeping a record of the writes
+//	owner:		the container publishing the key
+//	key:		the key in the store
+//	value:		the value to set
+//	ttl:		the ttl of the key, zero if the key does not expire
+func (r *ConfigHookService) setKey(owner *KeyOwner, key, value string, ttl time.Duration) error {
+	// step: claim the key, checking it isn't owned by another container
+	if isOwnerTracked() {
+		if err := r.claimOwner(owner, key, value, ttl); err != nil {
+			return err
+		}
+	}
+	storeWrites.Inc(r.backend)
+	var err error
+	if ttl > 0 {
+		err = r.store.SetTTL(key, value, ttl)
+	} else {
+		err = r.store.Set(key, value)
+	}
+	if err != nil {
+		storeWriteFailures.Inc(r.backend)
+		return err
+	}
+	return nil
+}
+
+// Handles a change to a key in the store, running the exec for any hook file using the key
+//	event:		the change event from the store
+func (r *ConfigHookService) processNodeChange(event store.NodeChange) {
+	if event.Operation != store.CHANGED {
+		return
+	}
+	r.RLock()
+	defer r.RUnlock()
+	for containerId, hooks := range r.hooks {
+		for _, file := range hooks.files {
+			if file.Exec.HasExec() && file.HasKey(event.Node.Path) {
+				// step: keep track of the execs so the shutdown can wait on them
+				r.execs.Add(1)
+				go func(containerId string, file *HookFile) {
+					defer r.execs.Done()
+					r.runExec(containerId, file)
+				}(containerId, file)
+			}
+		}
+	}
+}
+
+// Runs the check and, on success, the exec command for a hook file inside the container
+//	containerId:	the container to run the commands in
+//	file:			the hook file holding the exec
+func (r *ConfigHookService) runExec(containerId string, file *HookFile) {
+	// step: we don't want the same exec running concurrently, a one time hook only ever runs once
+	if !file.Exec.start(file.Flags.IsOneTime()) {
+		glog.V(5).Infof("The exec for hook: %s, container: %s is running or has already run", file.ID, containerId[:12])
+		return
+	}
+	for {
+		r.execute(containerId, file)
+		// step: if there was a change while running, we need to run again
+		if !file.Exec.finish() {
+			return
+		}
+	}
+}
+
+// Performs the check and exec commands for the hook file
+//	containerId:	the container to run the commands in
+//	file:			the hook file holding the exec
+func (r *ConfigHookService) execute(containerId string, file *HookFile) {
+	exec := file.Exec
+	// step: perform the check if required
+	if exec.HasCheck() {
+		code, output, err := r.executeCommand(containerId, "check", exec.Check)
+		exec.checked(code)
+		if err != nil {
+			glog.Errorf("Failed to run the check for hook: %s, container: %s, error: %s", file.ID, containerId[:12], err)
+			return
+		}
+		if code != 0 {
+			glog.Errorf("The check for hook: %s, container: %s failed, exit code: %d, output: %s",
+				file.ID, containerId[:12], code, output)
+			return
+		}
+	}
+	// step: run the exec command
+	code, output, err := r.executeCommand(containerId, "exec", exec.Exec)
+	exec.ran(code)
+	if err != nil {
+		glog.Errorf("Failed to run the exec for hook: %s, container: %s, error: %s", file.ID, containerId[:12], err)
+		return
+	}
+	glog.V(3).Infof("Ran the exec for hook: %s, container: %s, exit code: %d", file.ID, containerId[:12], code)
+	glog.V(10).Infof("Exec output for hook: %s, container: %s, output: %s", file.ID, containerId[:12], output)
+}
+
+// Executes a command in the container, keeping a record of the exit code and duration
+//	containerId:	the container to run the command in
+//	kind:			the type of command, exec or check
+//	command:		the command to run
+func (r *ConfigHookService) executeCommand(containerId, kind, command string) (int, string, error) {
+	started := time.Now()
+	code, output, err := r.docker.Execute(containerId, command)
+	execDuration.Observe(time.Since(started).Seconds(), kind)
+	exit_code := strconv.Itoa(code)
+	if err != nil {
+		exit_code = "error"
+	}
+	execRuns.Inc(kind, exit_code)
+	return code, output, err
+}
+
+// Extracts the keys file from the container and pushes each of the key pairs into the store
+//	containerId:	the container id which holds the file
+//	keys:			the hook keys to be published
+func (r *ConfigHookService) publishKeys(containerId string, keys *HookKeys) (err error) {
+	defer func() {
+		keys.Published(err)
+	}()
+	glog.V(5).Infof("Publishing the keys file: %s from container: %s", keys.File, containerId[:12])
+	// step: grab the content from the container
+	content, err := r.docker.GetFile(containerId, keys.File)
+	if err != nil {
+		return err
+	}
+	// step: parse the content into key pairs
+	pairs, errs := keys.Parse(content)
+	keys.Checksum = getChecksum(content)
+	for _, e := range errs {
+		glog.Errorf("Invalid entry in keys file: %s, container: %s, %s", keys.File, containerId[:12], e)
+	}
+	owner := r.newOwner(containerId, HOOK_KEYS, keys.ID)
+	if err = r.checkOwners(containerId, HOOK_KEYS, keys.ID, owner, pairs); err != nil {
+		return err
+	}
+	// step: push each of the keys into the store
+	failed := 0
+	for key, value := range pairs {
+		// step: a one time hook never overwrites an existing key
+		if keys.Flags.IsOneTime() {
+			found, e := r.store.Exists(key)
+			if e != nil {
+				glog.Errorf("Failed to check the key: %s from keys file: %s, container: %s, error: %s", key, keys.File, containerId[:12], e)
+				failed++
+				continue
+			}
+			if found {
+				continue
+			}
+		}
+		if e := r.setKey(owner, key, value, keyTTL(keys.TTL)); e != nil {
+			glog.Errorf("Failed to set the key: %s from keys file: %s, container: %s, error: %s", key, keys.File, containerId[:12], e)
+			failed++
+			continue
+		}
+		keys.Keys[key] = getChecksum(value)
+	}
+	// step: with a ttl the keys no longer in the file are left to expire, so we stop tracking them
+	if keyTTL(keys.TTL) > 0 {
+		for key, _ := range keys.Keys {
+			if _, found := pairs[key]; !found {
+				delete(keys.Keys, key)
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to set %d of %d keys from the file: %s", failed, len(pairs), keys.File)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("the file: %s contains %d invalid entries", keys.File, len(errs))
+	}
+	glog.V(3).Infof("Published %d keys from the file: %s, container: %s", len(pairs), keys.File, containerId[:12])
+	return nil
+}
+
+// Stops the refreshes and keep alives of a container which has exited; the hooks are kept so
+// the cleanup policy is applied when the container is destroyed, or replaced if it starts again
+//	containerId:	the id of the container
+func (r *ConfigHookService) processContainerStopped(containerId string) {
+	glog.V(5).Infof("Processing the stop of container: %s", containerId)
+	r.Lock()
+	defer r.Unlock()
+	if hooks, found := r.hooks[containerId]; found {
+		glog.V(5).Infof("Stopping the refreshes of the hooks for container: %s", containerId[:12])
+		hooks.Stop()
 	}
 }
 
 func (r *ConfigHookService) processContainerDestruction(containerId string) {
 	glog.V(5).Infof("Processing destruction of container: %s", containerId)
+	r.Lock()
+	defer r.Unlock()
+	delete(r.ignored, containerId)
 	// step: check if the hooks config exists for this
-	if _, found := r.hooks[containerId]; found {
-		// step: close up any of the resources used by this
-
-		// step: remove from the map
+	if hooks, found := r.hooks[containerId]; found {
+		// step: remove from the map and stop any refreshes
 		delete(r.hooks, containerId)
+		hooks.Stop()
+		// step: apply the cleanup policy to any keys published and release our ownership
+		r.cleanupHooks(containerId, hooks)
+		r.releaseOwners(containerId, hooks)
+		// step: remove any watches on keys no longer used by a hook
+		for _, file := range hooks.files {
+			if !file.Exec.HasExec() {
+				continue
+			}
+			for key, _ := range file.PublishedKeys() {
+				if !r.isWatched(key) {
+					r.store.Unwatch(key)
+				}
+			}
+		}
+	}
+}
+
+// Checks if any of the remaining hook files are watching the key
+//	key:		the key in the store
+func (r *ConfigHookService) isWatched(key string) bool {
+	for _, hooks := range r.hooks {
+		for _, file := range hooks.files {
+			if file.Exec.HasExec() && file.HasKey(key) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Applies any hooks found in the variables to the hooks config; the compact forms are applied
+// first so the long forms i.e. _KEY, _EXEC can override the fields
+//	containerId:	the id of the container
+//	hooks:			the hooks config for the container
+//	variables:		the environment variables or labels of the container
+//	isHook:			checks if the variable is a hook
+//	parse:			parses the variable into the hook type, name and element
+func (r *ConfigHookService) parseHooks(containerId string, hooks *Hooks, variables map[string]string,
+	isHook func(string) bool, parse func(string) (string, string, string, error)) {
+	for _, compact := range []bool{true, false} {
+		for key, value := range variables {
+			if !isHook(key) {
+				continue
+			}
+			hook, name, element, err := parse(key)
+			if err != nil {
+				if compact {
+					glog.Errorf("Invalid hook: %s in container: %s, error: %s", key, containerId, err)
+					hooks.Reject(hook, key, err)
+				}
+				continue
+			}
+			if (element == "") != compact {
+				continue
+			}
+			switch hook {
+			case HOOK_FILE:
+				err = hooks.Files(name).Set(element, value)
+			case HOOK_KEYS:
+				err = hooks.Keys(name).Set(element, value)
+			}
+			if err != nil {
+				glog.Errorf("Invalid hook: %s in container: %s, error: %s", key, containerId, err)
+			}
+		}
 	}
 }
 
@@ -199,27 +752,48 @@ func (r *ConfigHookService) hasConfig(containerId string) (*Hooks, bool, error)
 		return nil, false, err
 	}
 
+	// step: get the labels of the image and container
+	image_labels, container_labels, err := r.docker.Labels(containerId)
+	if err != nil {
+		glog.Errorf("Failed to inspect the labels of container: %s, error: %s", containerId, err)
+		return nil, false, err
+	}
+
 	// step: lets attempt to find config hooks
 	hooks := NewHooksConfig()
 
-	// step: iterate the environment vars and look for hooks
-	for key, value := range environment {
-		if hooks.IsHook(key) {
-			if hook, name, element, err := hooks.ParseKey(key); err == nil {
-				switch hook {
-				case HOOK_FILE:
-					hooks.Files(name).Set(element, value)
-				case HOOK_KEYS:
-					hooks.Keys(name).File = value
-				}
-			}
+	// step: the image is recorded in the owners of the keys
+	if isOwnerTracked() {
+		if hooks.image, err = r.docker.Image(containerId); err != nil {
+			glog.Errorf("Failed to inspect the image of container: %s, error: %s", containerId, err)
+			return nil, false, err
 		}
 	}
 
+	// step: the hooks are applied in order of precedence, image labels, container labels and then
+	// the environment; the container inherits the image labels, so we skip those unchanged
+	r.parseHooks(containerId, hooks, image_labels, hooks.IsLabel, hooks.ParseLabel)
+	labels := make(map[string]string, 0)
+	for label, value := range container_labels {
+		if inherited, found := image_labels[label]; !found || inherited != value {
+			labels[label] = value
+		}
+	}
+	r.parseHooks(containerId, hooks, labels, hooks.IsLabel, hooks.ParseLabel)
+	r.parseHooks(containerId, hooks, environment, hooks.IsHook, hooks.ParseKey)
+
+	// step: substitute any variables, the container environment takes precedence over the agent
+	hooks.Expand(environment, config.Options.Variables)
+
 	// step: we need to validate the hooks and remove anything which does satisfy
 	if err := hooks.Validate(); err != nil {
 		glog.Errorf("One or more configs had errors in container: %s, error: %s", containerId, err)
 	}
+	hooksParsed.Add(float64(len(hooks.files)), HOOK_FILE)
+	hooksParsed.Add(float64(len(hooks.keys)), HOOK_KEYS)
+	for _, rejected := range hooks.rejected {
+		hooksRejected.Inc(rejected.Hook)
+	}
 
 	return hooks, hooks.HasHooks(), nil
 }
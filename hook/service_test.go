@@ -0,0 +1,455 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gambol99/config-hook/config"
+	"github.com/gambol99/config-hook/store"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	TEST_CONTAINER = "0123456789abcdef0123456789abcdef"
+)
+
+/* a fake docker store for testing the service */
+type fakeDocker struct {
+	sync.Mutex
+	// the files in the containers
+	files map[string]map[string]string
+	// the environment of the containers
+	environment map[string]map[string]string
+	// the labels of the images and containers
+	image_labels, labels map[string]map[string]string
+	// the images of the containers
+	images map[string]string
+	// the containers which have stopped
+	stopped map[string]bool
+	// the commands executed in the containers
+	executed []string
+	// the exit codes for the commands
+	exitCodes map[string]int
+	// the channels watching for docker events
+	watches map[string]DockerEvent
+}
+
+func newFakeDocker() *fakeDocker {
+	return &fakeDocker{
+		files:        make(map[string]map[string]string, 0),
+		environment:  make(map[string]map[string]string, 0),
+		image_labels: make(map[string]map[string]string, 0),
+		labels:       make(map[string]map[string]string, 0),
+		images:       make(map[string]string, 0),
+		stopped:      make(map[string]bool, 0),
+		exitCodes:    make(map[string]int, 0),
+		watches:      make(map[string]DockerEvent, 0),
+	}
+}
+
+func (r *fakeDocker) GetFile(containerID, filename string) (string, error) {
+	r.Lock()
+	defer r.Unlock()
+	if content, found := r.files[containerID][filename]; found {
+		return content, nil
+	}
+	for name, _ := range r.files[containerID] {
+		if strings.HasPrefix(name, filename+"/") {
+			return "", DirectoryErr
+		}
+	}
+	return "", errors.New("file not found")
+}
+
+func (r *fakeDocker) GetDirectory(containerID, dirname string) (map[string]string, error) {
+	r.Lock()
+	defer r.Unlock()
+	files := make(map[string]string, 0)
+	for name, content := range r.files[containerID] {
+		if strings.HasPrefix(name, dirname+"/") {
+			files[strings.TrimPrefix(name, dirname+"/")] = content
+		}
+	}
+	if len(files) == 0 {
+		return nil, errors.New("directory not found")
+	}
+	return files, nil
+}
+
+func (r *fakeDocker) List(all bool) ([]string, error) {
+	r.Lock()
+	defer r.Unlock()
+	list := make([]string, 0)
+	for id, _ := range r.environment {
+		if all || !r.stopped[id] {
+			list = append(list, id)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeDocker) Watch(channel DockerEvent, event_type string) {
+	r.Lock()
+	defer r.Unlock()
+	r.watches[event_type] = channel
+}
+
+// sends a docker event to the service
+func (r *fakeDocker) send(event_type, containerID string) {
+	r.Lock()
+	channel := r.watches[event_type]
+	r.Unlock()
+	channel <- containerID
+}
+
+func (r *fakeDocker) Environment(containerID string) (map[string]string, error) {
+	r.Lock()
+	defer r.Unlock()
+	if environment, found := r.environment[containerID]; found {
+		return environment, nil
+	}
+	return nil, errors.New("no such container")
+}
+
+func (r *fakeDocker) Labels(containerID string) (map[string]string, map[string]string, error) {
+	r.Lock()
+	defer r.Unlock()
+	return r.image_labels[containerID], r.labels[containerID], nil
+}
+
+func (r *fakeDocker) Image(containerID string) (string, error) {
+	r.Lock()
+	defer r.Unlock()
+	return r.images[containerID], nil
+}
+
+func (r *fakeDocker) Execute(containerID, command string) (int, string, error) {
+	r.Lock()
+	defer r.Unlock()
+	r.executed = append(r.executed, command)
+	return r.exitCodes[command], "", nil
+}
+
+func (r *fakeDocker) Health() DockerHealth {
+	return DockerHealth{Connected: true}
+}
+
+func (r *fakeDocker) Close() {}
+
+func newTestService(t *testing.T) (*ConfigHookService, *fakeDocker) {
+	setHookPrefix("CONFIG_HOOK_")
+	setLabelPrefix("config-hook")
+	service := new(ConfigHookService)
+	service.update_channel = make(store.NodeUpdateChannel, 10)
+	service.content_changes = make(ContentChannel, 10)
+	service.hooks = make(map[string]*Hooks, 0)
+	service.ignored = make(map[string]bool, 0)
+	service.shutdown = make(ShutdownChannel)
+	service.stopped = make(ShutdownChannel)
+	kv, err := store.NewStore("memory://", service.update_channel)
+	assert.Nil(t, err)
+	service.store = kv
+	docker := newFakeDocker()
+	service.docker = docker
+	return service, docker
+}
+
+func TestServicePublishFile(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"ENVIRONMENT":               "prod",
+		"CONFIG_HOOK_FILE_HAPROXY":  "/etc/haproxy.cfg;/env/%ENVIRONMENT%/haproxy.cfg",
+		"CONFIG_HOOK_FILE_BAD":      "/etc/bad.cfg;/env/%MISSING%/bad.cfg",
+		"CONFIG_HOOK_KEYS_SETTINGS": "/etc/settings",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/haproxy.cfg": "haproxy config",
+		"/etc/settings":    "ONE=1\nTWO=2\n",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	node, err := service.store.Get("/env/prod/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+	node, err = service.store.Get("ONE")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", node.Value)
+
+	hooks := service.hooks[TEST_CONTAINER]
+	assert.NotNil(t, hooks)
+	assert.Equal(t, 1, len(hooks.files))
+	assert.True(t, hooks.files["HAPROXY"].IsPublished())
+	assert.Equal(t, 2, len(hooks.keys["SETTINGS"].Keys))
+}
+
+func TestServiceOneTime(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg;;;OT",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "new config"}
+	assert.Nil(t, service.store.Set("/env/haproxy.cfg", "existing config"))
+	service.processContainerCreation(TEST_CONTAINER)
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "existing config", node.Value)
+}
+
+func TestServiceCleanup(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":         "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_FILE_HAPROXY_CLEANUP": "delete",
+		"CONFIG_HOOK_FILE_NGINX":           "/etc/nginx.cfg;/env/nginx.cfg",
+		"CONFIG_HOOK_FILE_NGINX_CLEANUP":   "delete",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/haproxy.cfg": "haproxy config",
+		"/etc/nginx.cfg":   "nginx config",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+	// step: someone else has taken over the nginx key
+	assert.Nil(t, service.store.Set("/env/nginx.cfg", "another config"))
+	service.processContainerDestruction(TEST_CONTAINER)
+
+	found, err := service.store.Exists("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.False(t, found)
+	found, err = service.store.Exists("/env/nginx.cfg")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Nil(t, service.hooks[TEST_CONTAINER])
+}
+
+func TestServiceCleanupRemove(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_CERTS":         "/etc/certs;/env/certs",
+		"CONFIG_HOOK_FILE_CERTS_CLEANUP": "remove",
+		"CONFIG_HOOK_KEYS_APP":           "/app/app.env",
+		"CONFIG_HOOK_KEYS_APP_KEY":       "/env/app",
+		"CONFIG_HOOK_KEYS_APP_CLEANUP":   "remove",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/certs/ca.pem":        "ca",
+		"/etc/certs/hosts/web.pem": "web",
+		"/app/app.env":             "NAME=app\n",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+	// step: the keys beneath the base we did not publish, i.e. left from a former container
+	assert.Nil(t, service.store.Set("/env/certs/hosts/old.pem", "old"))
+	// step: someone else has changed one of the app keys
+	assert.Nil(t, service.store.Set("/env/app/OTHER", "other"))
+	assert.Nil(t, service.store.Set("/env/app/NAME", "changed"))
+	service.processContainerDestruction(TEST_CONTAINER)
+
+	found, err := service.store.Exists("/env/certs")
+	assert.Nil(t, err)
+	assert.False(t, found)
+	// step: the base is left alone when the keys published are no longer ours
+	for _, key := range []string{"/env/app/NAME", "/env/app/OTHER"} {
+		found, err = service.store.Exists(key)
+		assert.Nil(t, err)
+		assert.True(t, found)
+	}
+}
+
+func TestServiceRunExec(t *testing.T) {
+	service, docker := newTestService(t)
+	file := NewHookFile("HAPROXY")
+	file.Exec.Exec = "/usr/bin/restart"
+	file.Exec.Check = "/usr/bin/check"
+	docker.exitCodes["/usr/bin/check"] = 1
+	service.runExec(TEST_CONTAINER, file)
+	assert.Equal(t, []string{"/usr/bin/check"}, docker.executed)
+	assert.Equal(t, 1, file.Exec.LastCheckExitCode)
+	assert.True(t, file.Exec.LastRun.IsZero())
+
+	docker.exitCodes["/usr/bin/check"] = 0
+	service.runExec(TEST_CONTAINER, file)
+	assert.Equal(t, []string{"/usr/bin/check", "/usr/bin/check", "/usr/bin/restart"}, docker.executed)
+	assert.False(t, file.Exec.LastRun.IsZero())
+	assert.Equal(t, 0, file.Exec.LastExitCode)
+}
+
+func TestServiceContentChange(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	docker.files[TEST_CONTAINER]["/etc/haproxy.cfg"] = "updated config"
+	service.processContentChange(ContentChange{
+		ContainerID: TEST_CONTAINER,
+		Hook:        HOOK_FILE,
+		Name:        "HAPROXY",
+		Checksum:    getChecksum("updated config"),
+	})
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "updated config", node.Value)
+}
+
+func TestServiceLabels(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.image_labels[TEST_CONTAINER] = map[string]string{
+		"config-hook.file.haproxy.path": "/etc/haproxy.cfg",
+		"config-hook.file.haproxy.key":  "/image/haproxy.cfg",
+		"config-hook.keys.settings":     "/etc/settings",
+	}
+	docker.labels[TEST_CONTAINER] = map[string]string{
+		"config-hook.file.haproxy.path": "/etc/haproxy.cfg",
+		"config-hook.file.haproxy.key":  "/container/haproxy.cfg",
+		"config-hook.keys.settings":     "/etc/settings",
+		"config-hook.file.nginx":        "/etc/nginx.cfg;/container/nginx.cfg",
+	}
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_NGINX_KEY": "/env/nginx.cfg",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/haproxy.cfg": "haproxy config",
+		"/etc/nginx.cfg":   "nginx config",
+		"/etc/settings":    "ONE=1\n",
+	}
+	hooks, found, err := service.hasConfig(TEST_CONTAINER)
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 2, len(hooks.files))
+	assert.Equal(t, "/container/haproxy.cfg", hooks.files["HAPROXY"].Key)
+	assert.Equal(t, "/env/nginx.cfg", hooks.files["NGINX"].Key)
+	assert.Equal(t, "/etc/settings", hooks.keys["SETTINGS"].File)
+	assert.False(t, hooks.HasRejected())
+}
+
+func TestServiceClose(t *testing.T) {
+	service, docker := newTestService(t)
+	config.Options.Shutdown_Timeout = time.Second
+	config.Options.Interval = time.Hour
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":      "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_FILE_HAPROXY_EXEC": "/usr/bin/restart",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	service.processContainerCreation(TEST_CONTAINER)
+	assert.Nil(t, service.processEvents())
+	hooks := service.hooks[TEST_CONTAINER]
+
+	// step: the queued change should be processed and the exec waited on
+	service.update_channel <- store.NodeChange{Operation: store.CHANGED, Node: store.Node{Path: "/env/haproxy.cfg"}}
+	service.Close()
+	docker.Lock()
+	assert.Equal(t, []string{"/usr/bin/restart"}, docker.executed)
+	docker.Unlock()
+	select {
+	case <-service.stopped:
+	default:
+		t.Errorf("the event processor has not stopped")
+	}
+	select {
+	case <-hooks.shutdown:
+	default:
+		t.Errorf("the refresh of the hooks has not been stopped")
+	}
+	config.Options.Interval = 0
+}
+
+// waits for the condition to be true, failing the test after a second
+func waitUntil(t *testing.T, condition func() bool) {
+	for timeout := time.Now().Add(time.Second); !condition(); time.Sleep(5 * time.Millisecond) {
+		if time.Now().After(timeout) {
+			t.Fatalf("timed out waiting for the condition")
+		}
+	}
+}
+
+func TestServiceStartedTwice(t *testing.T) {
+	service, docker := newTestService(t)
+	config.Options.Interval = time.Hour
+	defer func() { config.Options.Interval = 0 }()
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY": "/etc/haproxy.cfg;/env/haproxy.cfg",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	assert.Nil(t, service.processEvents())
+	defer service.Close()
+	managed := func() *Hooks {
+		service.Lock()
+		defer service.Unlock()
+		return service.hooks[TEST_CONTAINER]
+	}
+
+	docker.send(DOCKER_START, TEST_CONTAINER)
+	waitUntil(t, func() bool { return managed() != nil })
+	first := managed()
+	// step: a restart or a replayed event must not leave the former refreshes running
+	docker.send(DOCKER_START, TEST_CONTAINER)
+	waitUntil(t, func() bool { return managed() != first })
+	select {
+	case <-first.shutdown:
+	default:
+		t.Errorf("the refresh of the former hooks has not been stopped")
+	}
+	select {
+	case <-managed().shutdown:
+		t.Errorf("the refresh of the current hooks has been stopped")
+	default:
+	}
+}
+
+func TestServicePublishDirectory(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_CERTS":         "/etc/certs;/env/certs",
+		"CONFIG_HOOK_FILE_CERTS_CLEANUP": "delete",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/certs/ca.pem":        "ca",
+		"/etc/certs/hosts/web.pem": "web",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	file := service.hooks[TEST_CONTAINER].files["CERTS"]
+	assert.True(t, file.IsPublished(), file.LastError)
+	assert.Equal(t, 2, len(file.Keys))
+	for key, value := range map[string]string{"/env/certs/ca.pem": "ca", "/env/certs/hosts/web.pem": "web"} {
+		node, err := service.store.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, value, node.Value)
+	}
+	checksum, err := service.readChecksum(TEST_CONTAINER, "/etc/certs")
+	assert.Nil(t, err)
+	assert.Equal(t, file.Checksum, checksum)
+
+	// step: the reconcile republishes a file of the tree which has been removed
+	assert.Nil(t, service.store.Delete("/env/certs/ca.pem"))
+	service.reconcile()
+	found, err := service.store.Exists("/env/certs/ca.pem")
+	assert.Nil(t, err)
+	assert.True(t, found)
+
+	// step: the cleanup applies to every key of the tree
+	service.processContainerDestruction(TEST_CONTAINER)
+	for _, key := range []string{"/env/certs/ca.pem", "/env/certs/hosts/web.pem"} {
+		found, err := service.store.Exists(key)
+		assert.Nil(t, err)
+		assert.False(t, found, "the key: %s should have been removed", key)
+	}
+}
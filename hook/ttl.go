@@ -0,0 +1,167 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"time"
+
+	"github.com/gambol99/config-hook/config"
+	"github.com/gambol99/config-hook/store"
+
+	"github.com/golang/glog"
+)
+
+// Retrieves the ttl for the keys of a hook, falling back to the agent ttl if not set
+//	ttl:		the ttl of the hook
+func keyTTL(ttl time.Duration) time.Duration {
+	if ttl <= 0 {
+		return config.Options.TTL
+	}
+	return ttl
+}
+
+// Starts keeping alive the keys of any hooks published with a ttl
+//	containerId:	the container holding the hooks
+//	hooks:			the hooks for the container
+func (r *ConfigHookService) keepAliveHooks(containerId string, hooks *Hooks) {
+	for name, file := range hooks.files {
+		if ttl := keyTTL(file.TTL); ttl > 0 {
+			go r.keepAlive(containerId, HOOK_FILE, name, ttl, hooks.shutdown)
+		}
+	}
+	for name, keys := range hooks.keys {
+		if ttl := keyTTL(keys.TTL); ttl > 0 {
+			go r.keepAlive(containerId, HOOK_KEYS, name, ttl, hooks.shutdown)
+		}
+	}
+}
+
+// Periodically refreshes the ttl on the keys of the hook, three times per ttl so a single
+// failed refresh doesn't expire the keys, until the hooks for the container are closed
+//	containerId:	the container holding the hook
+//	hook:			the type of hook
+//	name:			the name of the hook
+//	ttl:			the ttl of the keys
+//	shutdown:		the channel closed when the container is gone
+func (r *ConfigHookService) keepAlive(containerId, hook, name string, ttl time.Duration, shutdown ShutdownChannel) {
+	glog.V(5).Infof("Keeping alive the keys of hook: %s, container: %s with a ttl of %s", name, containerId[:12], ttl)
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.refreshTTL(containerId, hook, name, ttl)
+		case <-shutdown:
+			glog.V(5).Infof("Stopping the keep alive of hook: %s, container: %s", name, containerId[:12])
+			return
+		}
+	}
+}
+
+// Refreshes the ttl on the keys published by the hook; if any of the keys have expired, i.e.
+// the agent was unable to refresh in time, the hook is republished
+//	containerId:	the container holding the hook
+//	hook:			the type of hook
+//	name:			the name of the hook
+//	ttl:			the ttl of the keys
+func (r *ConfigHookService) refreshTTL(containerId, hook, name string, ttl time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	hooks, found := r.hooks[containerId]
+	if !found {
+		return
+	}
+	// step: we only refresh the keys we have published
+	keys := make([]string, 0)
+	switch hook {
+	case HOOK_FILE:
+		if file, found := hooks.files[name]; found && file.Checksum != "" {
+			for key, _ := range file.PublishedKeys() {
+				keys = append(keys, key)
+			}
+		}
+	case HOOK_KEYS:
+		if published, found := hooks.keys[name]; found {
+			for key, _ := range published.Keys {
+				keys = append(keys, key)
+			}
+		}
+	}
+	expired := false
+	for _, key := range keys {
+		err := r.store.Refresh(key, ttl)
+		if err == store.KeyNotFoundErr {
+			glog.Warningf("The ttl on key: %s of hook: %s, container: %s has expired, republishing", key, name, containerId[:12])
+			keyRefreshes.Inc("expired")
+			expired = true
+			break
+		}
+		if err != nil {
+			glog.Errorf("Failed to refresh the ttl on key: %s of hook: %s, container: %s, error: %s", key, name, containerId[:12], err)
+			keyRefreshes.Inc("error")
+			continue
+		}
+		keyRefreshes.Inc("ok")
+		r.refreshOwner(containerId, hook, name, key, ttl)
+		if hook == HOOK_FILE {
+			r.refreshMetadata(hooks.files[name], key, ttl)
+		}
+	}
+	if !expired {
+		return
+	}
+	switch hook {
+	case HOOK_FILE:
+		if err := r.publishFile(containerId, hooks.files[name]); err != nil {
+			glog.Errorf("Failed to republish the hook file: %s, container: %s, error: %s", name, containerId[:12], err)
+		}
+	case HOOK_KEYS:
+		if err := r.publishKeys(containerId, hooks.keys[name]); err != nil {
+			glog.Errorf("Failed to republish the hook keys: %s, container: %s, error: %s", name, containerId[:12], err)
+		}
+	}
+}
+
+// Refreshes the ttl on the owner of a key; if the owner has expired and no one else has taken
+// the key we record ourselves again, otherwise the key is left with its owner
+//	containerId:	the container holding the hook
+//	hook:			the type of hook
+//	name:			the name of the hook
+//	key:			the key in the store
+//	ttl:			the ttl of the key
+func (r *ConfigHookService) refreshOwner(containerId, hook, name, key string, ttl time.Duration) {
+	if !isOwnerTracked() {
+		return
+	}
+	err := r.store.Refresh(ownerKey(key), ttl)
+	if err == nil {
+		return
+	}
+	if err != store.KeyNotFoundErr {
+		glog.Errorf("Failed to refresh the ttl on the owner of key: %s, container: %s, error: %s", key, containerId[:12], err)
+		return
+	}
+	if current, _, err := r.keyOwner(key); err != nil || current != nil {
+		return
+	}
+	// step: the record is only created if no one has claimed the key in the meantime
+	err = r.setOwner(r.newOwner(containerId, hook, name), key, 0, ttl)
+	switch err {
+	case nil:
+	case store.KeyModifiedErr:
+		glog.V(3).Infof("The key: %s has been claimed by another container, container: %s", key, containerId[:12])
+	default:
+		glog.Errorf("Failed to record the owner of key: %s, container: %s, error: %s", key, containerId[:12], err)
+	}
+}
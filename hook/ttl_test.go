@@ -0,0 +1,144 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gambol99/config-hook/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyTTL(t *testing.T) {
+	assert.Equal(t, config.Options.TTL, keyTTL(0))
+	assert.Equal(t, 10*time.Second, keyTTL(10*time.Second))
+}
+
+func TestHookTTLSet(t *testing.T) {
+	file := NewHookFile("test")
+	assert.Nil(t, file.Set("TTL", "30s"))
+	assert.Equal(t, 30*time.Second, file.TTL)
+	assert.NotNil(t, file.Set("TTL", "-1s"))
+
+	keys := NewHookKeys("test")
+	assert.Nil(t, keys.Set("TTL", "1m"))
+	assert.Equal(t, time.Minute, keys.TTL)
+	assert.NotNil(t, keys.Set("TTL", "later"))
+}
+
+func TestServiceTTL(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":      "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_FILE_HAPROXY_TTL":  "300ms",
+		"CONFIG_HOOK_KEYS_SETTINGS":     "/etc/settings",
+		"CONFIG_HOOK_KEYS_SETTINGS_TTL": "300ms",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/haproxy.cfg": "haproxy config",
+		"/etc/settings":    "ONE=1\n",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+
+	// step: the keys are kept alive while the container is running
+	time.Sleep(600 * time.Millisecond)
+	for _, key := range []string{"/env/haproxy.cfg", "ONE"} {
+		found, err := service.store.Exists(key)
+		assert.Nil(t, err)
+		assert.True(t, found, "the key: %s should have been kept alive", key)
+	}
+
+	// step: once the container has gone the keys expire
+	service.processContainerDestruction(TEST_CONTAINER)
+	time.Sleep(500 * time.Millisecond)
+	for _, key := range []string{"/env/haproxy.cfg", "ONE"} {
+		found, err := service.store.Exists(key)
+		assert.Nil(t, err)
+		assert.False(t, found, "the key: %s should have expired", key)
+	}
+}
+
+func TestServiceTTLExpired(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":     "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_FILE_HAPROXY_TTL": "1m",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{
+		"/etc/haproxy.cfg": "haproxy config",
+	}
+	service.processContainerCreation(TEST_CONTAINER)
+	defer service.processContainerDestruction(TEST_CONTAINER)
+
+	// step: a key which has expired is republished on the next refresh
+	assert.Nil(t, service.store.Delete("/env/haproxy.cfg"))
+	service.refreshTTL(TEST_CONTAINER, HOOK_FILE, "HAPROXY", time.Minute)
+	node, err := service.store.Get("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.Equal(t, "haproxy config", node.Value)
+}
+
+func TestServiceTTLStopped(t *testing.T) {
+	service, docker := newTestService(t)
+	docker.environment[TEST_CONTAINER] = map[string]string{
+		"CONFIG_HOOK_FILE_HAPROXY":          "/etc/haproxy.cfg;/env/haproxy.cfg",
+		"CONFIG_HOOK_FILE_HAPROXY_TTL":      "300ms",
+		"CONFIG_HOOK_FILE_HAPROXY_INTERVAL": "100ms",
+		"CONFIG_HOOK_FILE_HAPROXY_CLEANUP":  "delete",
+	}
+	docker.files[TEST_CONTAINER] = map[string]string{"/etc/haproxy.cfg": "haproxy config"}
+	assert.Nil(t, service.processEvents())
+	defer service.Close()
+	managed := func() *Hooks {
+		service.Lock()
+		defer service.Unlock()
+		return service.hooks[TEST_CONTAINER]
+	}
+	docker.send(DOCKER_START, TEST_CONTAINER)
+	waitUntil(t, func() bool { return managed() != nil })
+	hooks := managed()
+
+	// step: once the container exits the keep alive and refresh stop and the key expires
+	docker.send(DOCKER_DIE, TEST_CONTAINER)
+	waitUntil(t, func() bool {
+		select {
+		case <-hooks.shutdown:
+			return true
+		default:
+			return false
+		}
+	})
+	time.Sleep(500 * time.Millisecond)
+	found, err := service.store.Exists("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.False(t, found, "the key should have expired once the container stopped")
+	// step: the hooks are kept so the cleanup is applied on the destroy
+	assert.Equal(t, hooks, managed())
+
+	// step: a container started again has its hooks republished and kept alive
+	docker.send(DOCKER_START, TEST_CONTAINER)
+	waitUntil(t, func() bool { return managed() != hooks })
+	time.Sleep(500 * time.Millisecond)
+	found, err = service.store.Exists("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.True(t, found, "the key should have been republished when the container started")
+
+	docker.send(DOCKER_STOP, TEST_CONTAINER)
+	docker.send(DOCKER_DESTROY, TEST_CONTAINER)
+	waitUntil(t, func() bool { return managed() == nil })
+	found, err = service.store.Exists("/env/haproxy.cfg")
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
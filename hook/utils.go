@@ -14,11 +14,21 @@ limitations under the License.
 package hook
 
 import (
-	"os"
-
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 )
 
+// the regex used to find a %NAME% placeholder at the start of the value
+var variable_regex = regexp.MustCompile(`^%([[:alpha:]_][[:alnum:]_]*)%`)
+
 type ShutdownChannel chan bool
 
 func isValidSocket(filename string) (bool, error) {
@@ -33,3 +43,170 @@ func isValidSocket(filename string) (bool, error) {
 	}
 	return true, nil
 }
+
+// Compares two keys from the store, ignoring any leading or trailing slashes
+func isSameKey(a, b string) bool {
+	return strings.Trim(a, "/") == strings.Trim(b, "/")
+}
+
+// Splits a compact hook value into its semicolon separated fields. A semicolon can be
+// escaped with a backslash, or placed inside single or double quotes; quotes which wrap
+// an entire field are removed, otherwise they are kept for the shell
+//	value:		the compact hook value i.e. PATH;KEY;EXEC;CHECK;FLAGS
+func splitCompact(value string) ([]string, error) {
+	fields := make([]string, 0)
+	var field []rune
+	var quote rune
+	escaped := false
+	for _, c := range value {
+		switch {
+		case escaped:
+			// step: we only unescape the characters we are interested in
+			if c != ';' && c != '\\' && c != '"' && c != '\'' {
+				field = append(field, '\\')
+			}
+			field = append(field, c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			field = append(field, c)
+		case c == '"' || c == '\'':
+			quote = c
+			field = append(field, c)
+		case c == ';':
+			fields = append(fields, unquote(string(field)))
+			field = field[:0]
+		default:
+			field = append(field, c)
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("the value: " + value + " has an unterminated quote")
+	}
+	if escaped {
+		field = append(field, '\\')
+	}
+	return append(fields, unquote(string(field))), nil
+}
+
+// Removes the quotes from a field if they wrap the entire content
+func unquote(field string) string {
+	field = strings.TrimSpace(field)
+	if len(field) >= 2 {
+		if (field[0] == '"' || field[0] == '\'') && field[len(field)-1] == field[0] {
+			return field[1 : len(field)-1]
+		}
+	}
+	return field
+}
+
+// Substitutes any %NAME% placeholders in the value, the variables are searched in the order given;
+// a %% is a literal % and an unresolved placeholder is an error
+//	value:		the value containing the placeholders
+//	variables:	the maps of variables to resolve from
+func expandVariables(value string, variables ...map[string]string) (string, error) {
+	return expand(value, true, variables)
+}
+
+// Substitutes any %NAME% placeholders in a command as expandVariables does, though a placeholder
+// naming no variable is left as is, so the command can use %, i.e. date +%H%M
+//	command:	the exec or check command
+//	variables:	the maps of variables to resolve from
+func expandCommand(command string, variables ...map[string]string) string {
+	expanded, _ := expand(command, false, variables)
+	return expanded
+}
+
+// Scans the value for the %% escapes and the %NAME% placeholders
+//	value:		the value containing the placeholders
+//	strict:		an unresolved placeholder is an error, otherwise it's left as is
+//	variables:	the maps of variables to resolve from
+func expand(value string, strict bool, variables []map[string]string) (string, error) {
+	missing := make([]string, 0)
+	var expanded bytes.Buffer
+	for index := 0; index < len(value); {
+		if value[index] != '%' {
+			expanded.WriteByte(value[index])
+			index++
+			continue
+		}
+		if strings.HasPrefix(value[index:], "%%") {
+			expanded.WriteByte('%')
+			index += 2
+			continue
+		}
+		matches := variable_regex.FindStringSubmatch(value[index:])
+		if matches == nil {
+			expanded.WriteByte('%')
+			index++
+			continue
+		}
+		resolved, found := lookupVariable(matches[1], variables)
+		switch {
+		case found:
+			expanded.WriteString(resolved)
+			index += len(matches[0])
+		case strict:
+			missing = append(missing, matches[1])
+			expanded.WriteString(matches[0])
+			index += len(matches[0])
+		default:
+			// step: the closing % may open the next placeholder
+			expanded.WriteByte('%')
+			index++
+		}
+	}
+	if len(missing) > 0 {
+		return "", errors.New("unable to resolve the variables: " + strings.Join(missing, ", ") + " in: " + value)
+	}
+	return expanded.String(), nil
+}
+
+// Looks up the variable in the maps of variables, in the order given
+func lookupVariable(name string, variables []map[string]string) (string, bool) {
+	for _, vars := range variables {
+		if resolved, found := vars[name]; found {
+			return resolved, true
+		}
+	}
+	return "", false
+}
+
+// Generates a checksum of the content
+func getChecksum(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// Computes the checksum of the files in a directory, the names as well as the content
+//	files:		the content of the files keyed by their path
+func getTreeChecksum(files map[string]string) string {
+	names := make([]string, 0)
+	for name, _ := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	hash := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(hash, "%s\x00%s\x00", name, getChecksum(files[name]))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Parses a duration from a hook element, i.e. INTERVAL or TTL, which cannot be negative
+//	element:	the name of the element
+//	value:		the value of the element, i.e. 30s
+func parseDuration(element, value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if duration < 0 {
+		return 0, errors.New("the " + strings.ToLower(element) + ": " + value + " cannot be negative")
+	}
+	return duration, nil
+}
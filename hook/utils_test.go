@@ -0,0 +1,71 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSameKey(t *testing.T) {
+	assert.True(t, isSameKey("/env/prod/haproxy", "env/prod/haproxy"))
+	assert.True(t, isSameKey("/env/prod/haproxy/", "/env/prod/haproxy"))
+	assert.False(t, isSameKey("/env/prod/haproxy", "/env/dev/haproxy"))
+}
+
+func TestSplitCompact(t *testing.T) {
+	fields, err := splitCompact(`/a;/b;'c;d';e "f;g";h\;i;j\k`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/a", "/b", "c;d", `e "f;g"`, "h;i", `j\k`}, fields)
+	fields, err = splitCompact("/a")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/a"}, fields)
+	_, err = splitCompact(`/a;"/b`)
+	assert.NotNil(t, err)
+}
+
+func TestExpandVariables(t *testing.T) {
+	environment := map[string]string{"ENVIRONMENT": "prod"}
+	agent := map[string]string{"ENVIRONMENT": "dev", "DC": "eu"}
+	value, err := expandVariables("/env/%ENVIRONMENT%/%DC%/haproxy.cfg", environment, agent)
+	assert.Nil(t, err)
+	assert.Equal(t, "/env/prod/eu/haproxy.cfg", value)
+	value, err = expandVariables("/env/prod/haproxy.cfg", environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "/env/prod/haproxy.cfg", value)
+	_, err = expandVariables("/env/%MISSING%/haproxy.cfg", environment, agent)
+	assert.NotNil(t, err)
+	// step: a %% is a literal %
+	value, err = expandVariables("/env/%%ENVIRONMENT%%/%ENVIRONMENT%/100%%", environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "/env/%ENVIRONMENT%/prod/100%", value)
+	value, err = expandVariables("/env/50%/%ENVIRONMENT%", environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "/env/50%/prod", value)
+}
+
+func TestExpandCommand(t *testing.T) {
+	environment := map[string]string{"ENVIRONMENT": "prod", "M": "minutes"}
+	for command, expected := range map[string]string{
+		"date +%H%M":                          "date +%H%M",
+		`printf "%s%s" a b`:                   `printf "%s%s" a b`,
+		"echo %ENVIRONMENT% %MISSING%":        "echo prod %MISSING%",
+		"printf %s%ENVIRONMENT%":              "printf %sprod",
+		"printf '%%d%%%%' 1":                  "printf '%d%%' 1",
+		"date +%H%M% > /tmp/%ENVIRONMENT%.at": "date +%Hminutes > /tmp/prod.at",
+	} {
+		assert.Equal(t, expected, expandCommand(command, environment), "command: %s", command)
+	}
+}
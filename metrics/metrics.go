@@ -0,0 +1,152 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// the default buckets for the histograms, in seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// The collection of metrics exposed by the agent
+type Registry struct {
+	sync.RWMutex
+	// the prometheus registry holding the collectors
+	registry *prometheus.Registry
+	// the handler serving the registry
+	handler http.Handler
+	// functions called before the metrics are gathered
+	collectors []func()
+}
+
+// the default registry used by the agent
+var DefaultRegistry = NewRegistry()
+
+func NewRegistry() *Registry {
+	registry := prometheus.NewRegistry()
+	return &Registry{
+		registry: registry,
+		handler:  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+	}
+}
+
+// Adds a collector to the registry, panics if one of the same name is already registered
+func (r *Registry) Register(collector prometheus.Collector) {
+	r.registry.MustRegister(collector)
+}
+
+// Adds a function to be called prior to the metrics being gathered, i.e. to update gauges
+func (r *Registry) OnCollect(collector func()) {
+	r.Lock()
+	defer r.Unlock()
+	r.collectors = append(r.collectors, collector)
+}
+
+// Calls the collect functions and serves the metrics in the prometheus exposition format
+func (r *Registry) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	r.RLock()
+	collectors := r.collectors
+	r.RUnlock()
+	for _, collector := range collectors {
+		collector()
+	}
+	r.handler.ServeHTTP(writer, request)
+}
+
+// The http handler for the default registry
+func Handler() http.Handler {
+	return DefaultRegistry
+}
+
+// Creates and registers a counter in the default registry
+func RegisterCounter(name, help string, labels ...string) *Counter {
+	counter := NewCounter(name, help, labels...)
+	DefaultRegistry.Register(counter)
+	return counter
+}
+
+// Creates and registers a gauge in the default registry
+func RegisterGauge(name, help string, labels ...string) *Gauge {
+	gauge := NewGauge(name, help, labels...)
+	DefaultRegistry.Register(gauge)
+	return gauge
+}
+
+// Creates and registers a histogram in the default registry
+func RegisterHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	histogram := NewHistogram(name, help, buckets, labels...)
+	DefaultRegistry.Register(histogram)
+	return histogram
+}
+
+// A value which can only go up, the label values are given in the order of the labels;
+// a metric without labels is exposed from its creation
+type Counter struct {
+	*prometheus.CounterVec
+}
+
+func NewCounter(name, help string, labels ...string) *Counter {
+	metric := &Counter{prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)}
+	if len(labels) == 0 {
+		metric.WithLabelValues()
+	}
+	return metric
+}
+
+func (r *Counter) Inc(values ...string) {
+	r.WithLabelValues(values...).Inc()
+}
+
+func (r *Counter) Add(value float64, values ...string) {
+	r.WithLabelValues(values...).Add(value)
+}
+
+// A value which can go up and down
+type Gauge struct {
+	*prometheus.GaugeVec
+}
+
+func NewGauge(name, help string, labels ...string) *Gauge {
+	metric := &Gauge{prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels)}
+	if len(labels) == 0 {
+		metric.WithLabelValues()
+	}
+	return metric
+}
+
+func (r *Gauge) Set(value float64, values ...string) {
+	r.WithLabelValues(values...).Set(value)
+}
+
+// A distribution of observations in buckets
+type Histogram struct {
+	*prometheus.HistogramVec
+}
+
+func NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	metric := &Histogram{prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels)}
+	if len(labels) == 0 {
+		metric.WithLabelValues()
+	}
+	return metric
+}
+
+func (r *Histogram) Observe(value float64, values ...string) {
+	r.WithLabelValues(values...).Observe(value)
+}
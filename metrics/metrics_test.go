@@ -0,0 +1,92 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	registry := NewRegistry()
+	counter := NewCounter("test_total", "a test counter", "status")
+	registry.Register(counter)
+	counter.Inc("start")
+	counter.Add(2, "start")
+	counter.Inc(`di"e`)
+	content := scrape(t, registry)
+	assert.Contains(t, content, "# HELP test_total a test counter\n# TYPE test_total counter\n")
+	assert.Contains(t, content, `test_total{status="di\"e"} 1`)
+	assert.Contains(t, content, `test_total{status="start"} 3`)
+}
+
+func TestGauge(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(NewGauge("test_depth", "a test gauge"))
+	gauge := NewGauge("test_queue", "a test gauge", "queue")
+	registry.Register(gauge)
+	content := scrape(t, registry)
+	assert.Contains(t, content, "# TYPE test_depth gauge\n")
+	assert.Contains(t, content, "test_depth 0\n")
+	gauge.Set(4, "updates")
+	assert.Contains(t, scrape(t, registry), `test_queue{queue="updates"} 4`)
+}
+
+func TestHistogram(t *testing.T) {
+	registry := NewRegistry()
+	histogram := NewHistogram("test_seconds", "a test histogram", []float64{0.5, 1}, "type")
+	registry.Register(histogram)
+	histogram.Observe(0.2, "exec")
+	histogram.Observe(0.7, "exec")
+	histogram.Observe(3, "exec")
+	content := scrape(t, registry)
+	assert.Contains(t, content, `test_seconds_bucket{type="exec",le="0.5"} 1`)
+	assert.Contains(t, content, `test_seconds_bucket{type="exec",le="1"} 2`)
+	assert.Contains(t, content, `test_seconds_bucket{type="exec",le="+Inf"} 3`)
+	assert.Contains(t, content, `test_seconds_sum{type="exec"} 3.9`)
+	assert.Contains(t, content, `test_seconds_count{type="exec"} 3`)
+}
+
+func TestRegistryOnCollect(t *testing.T) {
+	registry := NewRegistry()
+	gauge := NewGauge("test_queue", "a test gauge", "queue")
+	registry.Register(NewCounter("test_events_total", "a test counter"))
+	registry.Register(gauge)
+	registry.OnCollect(func() {
+		gauge.Set(2, "updates")
+	})
+	content := scrape(t, registry)
+	assert.Contains(t, content, `test_queue{queue="updates"} 2`)
+	assert.Contains(t, content, "test_events_total 0")
+}
+
+func TestRegistryDuplicate(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(NewCounter("test_total", "a test counter"))
+	assert.Panics(t, func() {
+		registry.Register(NewCounter("test_total", "a test counter"))
+	})
+}
+
+func scrape(t *testing.T, registry *Registry) string {
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/metrics", nil)
+	registry.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "text/plain")
+	return recorder.Body.String()
+}
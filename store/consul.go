@@ -0,0 +1,723 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gambol99/config-hook/config"
+
+	"github.com/golang/glog"
+)
+
+type ConsulStoreClient struct {
+	/* a lock for the watcher and session maps */
+	sync.RWMutex
+	/* a list of consul hosts */
+	hosts []string
+	/* the http client used to talk to consul */
+	client *http.Client
+	/* a map of keys presently being watched and the channel to stop them */
+	watchedKeys map[string]chan bool
+	/* a map of the keys set with a ttl and the session holding them */
+	sessions map[string]string
+	/* the channel used to send node updates */
+	update_channel chan NodeChange
+	/* the initial and maximum backoff of the watches */
+	retry_min, retry_max time.Duration
+}
+
+const (
+	CONSUL_PREFIX    = "consul://"
+	CONSUL_KV_PATH   = "/v1/kv/"
+	CONSUL_SESSION   = "/v1/session/"
+	CONSUL_TXN       = "/v1/txn"
+	CONSUL_MIN_TTL   = 10
+	CONSUL_WAIT_TIME = "5m"
+	CONSUL_INDEX     = "X-Consul-Index"
+	CONSUL_TOKEN     = "X-Consul-Token"
+	CONSUL_RETRY_MIN = 1 * time.Second
+	CONSUL_RETRY_MAX = 60 * time.Second
+)
+
+/* a key/value pair as returned by the consul kv api */
+type consulKV struct {
+	Key         string
+	Value       []byte
+	ModifyIndex uint64
+}
+
+/* an operation on a key in a consul transaction */
+type consulTxnOp struct {
+	KV consulTxnKV
+}
+
+type consulTxnKV struct {
+	Verb    string
+	Key     string
+	Value   []byte `json:",omitempty"`
+	Index   uint64 `json:",omitempty"`
+	Session string `json:",omitempty"`
+}
+
+func NewConsulStoreClient(location *url.URL, channel NodeUpdateChannel) (Store, error) {
+	store := new(ConsulStoreClient)
+	store.hosts = store.parseHostsURL(location)
+	store.update_channel = channel
+	store.watchedKeys = make(map[string]chan bool, 0)
+	store.sessions = make(map[string]string, 0)
+	store.retry_min = CONSUL_RETRY_MIN
+	store.retry_max = CONSUL_RETRY_MAX
+
+	glog.Infof("Creating a Consul Agent for K/V Store, hosts: %s", store.hosts)
+
+	/* step: create the http client */
+	transport := &http.Transport{}
+	if config.Options.Consul.CACert_File != "" {
+		tls_config, err := newTLSConfig(config.Options.Consul.TLSOptions)
+		if err != nil {
+			glog.Errorf("Failed to create a TLS connection to consul: %s, error: %s", location, err)
+			return nil, err
+		}
+		transport.TLSClientConfig = tls_config
+	}
+	store.client = &http.Client{Transport: transport}
+	return store, nil
+}
+
+func (r *ConsulStoreClient) parseHostsURL(location *url.URL) []string {
+	hosts := make([]string, 0)
+	/* step: determine the protocol */
+	protocol := "http"
+	if config.Options.Consul.CACert_File != "" {
+		protocol = "https"
+	}
+	for _, host := range strings.Split(location.Host, ",") {
+		hosts = append(hosts, fmt.Sprintf("%s://%s", protocol, host))
+	}
+	return hosts
+}
+
+func (r *ConsulStoreClient) Close() {
+	glog.Infof("Shutting down the consul client")
+	r.Lock()
+	defer r.Unlock()
+	for key, stop := range r.watchedKeys {
+		close(stop)
+		delete(r.watchedKeys, key)
+	}
+}
+
+func (r *ConsulStoreClient) Watch(key string) {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	// step: we check if the key is being watched and if not add it
+	if _, found := r.watchedKeys[key]; found {
+		glog.V(VERBOSE_LEVEL).Infof("Thy key: %s is already being wathed, skipping for now", key)
+		return
+	}
+	glog.V(VERBOSE_LEVEL).Infof("Adding a watch on the key: %s", key)
+	stop := make(chan bool)
+	r.watchedKeys[key] = stop
+	go r.watchKey(key, stop)
+}
+
+func (r *ConsulStoreClient) Unwatch(key string) {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	if stop, found := r.watchedKeys[key]; found {
+		close(stop)
+		delete(r.watchedKeys, key)
+	}
+}
+
+// Performs a blocking query on the key prefix, emitting events for any differences. Following
+// the consul guidance the index never drops below one, as a query on zero returns immediately,
+// and we back off after an error or a query which returned early without the index moving on
+//	key:		the key prefix being watched
+//	stop:		the channel closed when the watch should stop
+func (r *ConsulStoreClient) watchKey(key string, stop chan bool) {
+	glog.V(VERBOSE_LEVEL).Infof("Starting the blocking query watcher for key: %s", key)
+	wait_index := uint64(0)
+	backoff := r.retry_min
+	var previous map[string]*consulKV
+	for {
+		select {
+		case <-stop:
+			glog.V(VERBOSE_LEVEL).Infof("Exitted the watcher for key: %s", key)
+			return
+		default:
+		}
+		query := url.Values{}
+		query.Set("recurse", "")
+		query.Set("index", strconv.FormatUint(wait_index, 10))
+		query.Set("wait", CONSUL_WAIT_TIME)
+		started := time.Now()
+		entries, index, err := r.getKV(key, query)
+		if err != nil && err != KeyNotFoundErr {
+			glog.Errorf("Failed to attempting to watch the key: %s, retrying in %s, error: %s", key, backoff, err)
+			watchReconnects.Inc("consul")
+			if !r.backoff(&backoff, stop) {
+				return
+			}
+			continue
+		}
+		// step: a query which returned early without the index moving on, i.e. a missing or
+		// reset index, is rate limited so we don't spin against consul
+		if previous != nil && index <= wait_index && time.Since(started) < r.retry_min {
+			glog.V(VERBOSE_LEVEL).Infof("The watch on key: %s returned early, index: %d, retrying in %s", key, index, backoff)
+			if !r.backoff(&backoff, stop) {
+				return
+			}
+		} else {
+			backoff = r.retry_min
+		}
+		// step: if the index has gone backwards we reset it, it must never be below one
+		if index < wait_index {
+			index = 0
+		}
+		if index < 1 {
+			index = 1
+		}
+		wait_index = index
+		// step: build a snapshot of the keys under the prefix
+		current := make(map[string]*consulKV, 0)
+		for _, entry := range entries {
+			current[entry.Key] = entry
+		}
+		// step: the first query is simply the baseline
+		if previous != nil {
+			select {
+			case <-stop:
+				return
+			default:
+				for _, event := range r.changes(previous, current) {
+					r.sendEvent(event)
+				}
+			}
+		}
+		previous = current
+	}
+}
+
+// Waits for the backoff to pass, doubling it up to the maximum; false if the watch was stopped
+//	wait:		the present backoff
+//	stop:		the channel closed when the watch should stop
+func (r *ConsulStoreClient) backoff(wait *time.Duration, stop chan bool) bool {
+	select {
+	case <-time.After(*wait):
+	case <-stop:
+		return false
+	}
+	if *wait *= 2; *wait > r.retry_max {
+		*wait = r.retry_max
+	}
+	return true
+}
+
+// Compares two snapshots of a prefix and generates the events for the differences
+func (r *ConsulStoreClient) changes(previous, current map[string]*consulKV) []NodeChange {
+	events := make([]NodeChange, 0)
+	for key, entry := range current {
+		if former, found := previous[key]; !found || former.ModifyIndex != entry.ModifyIndex {
+			events = append(events, r.createEvent(entry, CHANGED))
+		}
+	}
+	for key, entry := range previous {
+		if _, found := current[key]; !found {
+			events = append(events, r.createEvent(entry, DELETED))
+		}
+	}
+	return events
+}
+
+func (r *ConsulStoreClient) createEvent(entry *consulKV, operation Action) NodeChange {
+	var event NodeChange
+	event.Node = *r.createNode(entry)
+	event.Operation = operation
+	return event
+}
+
+func (r *ConsulStoreClient) sendEvent(event NodeChange) {
+	glog.V(VERBOSE_LEVEL).Infof("Sending notification of change on key: %s, channel: %v, event: %v", event.Node.Path, r.update_channel, event)
+	go func() {
+		r.update_channel <- event
+	}()
+}
+
+/* consul keys do not have a leading slash */
+func (r *ConsulStoreClient) validateKey(key string) string {
+	return strings.Trim(key, "/")
+}
+
+func (r *ConsulStoreClient) Get(key string) (*Node, error) {
+	lookup := r.validateKey(key)
+	glog.V(VERBOSE_LEVEL).Infof("Get() key: %s", lookup)
+	entries, _, err := r.getKV(lookup, nil)
+	if err == KeyNotFoundErr {
+		/* step: consul has no directories, a prefix with keys beneath it is treated as one */
+		directory, err := r.isDirectory(lookup)
+		if err != nil {
+			return nil, err
+		}
+		if directory {
+			return &Node{Path: "/" + lookup, Directory: true}, nil
+		}
+		return nil, KeyNotFoundErr
+	}
+	if err != nil {
+		glog.Errorf("Failed to get the key: %s, error: %s", lookup, err)
+		return nil, err
+	}
+	return r.createNode(entries[0]), nil
+}
+
+func (r *ConsulStoreClient) Exists(key string) (bool, error) {
+	glog.V(VERBOSE_LEVEL).Infof("Exists() key: %s", key)
+	lookup := r.validateKey(key)
+	if _, _, err := r.getKV(lookup, nil); err != nil {
+		if err == KeyNotFoundErr {
+			return r.isDirectory(lookup)
+		}
+		glog.Errorf("Failed to check the key: %s exists, error: %s", key, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Checks if there are any keys beneath the path, i.e. the path is a directory
+//	path:		the path in the kv store
+func (r *ConsulStoreClient) isDirectory(path string) (bool, error) {
+	query := url.Values{}
+	query.Set("keys", "")
+	prefix := path + "/"
+	if path == "" {
+		prefix = ""
+	}
+	response, _, err := r.request("GET", prefix, query, nil)
+	if err == KeyNotFoundErr {
+		return false, nil
+	}
+	if err != nil {
+		glog.Errorf("Failed to check the path: %s is a directory, error: %s", path, err)
+		return false, err
+	}
+	keys := make([]string, 0)
+	if err := json.Unmarshal(response, &keys); err != nil {
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
+func (r *ConsulStoreClient) Set(key string, value string) error {
+	glog.V(VERBOSE_LEVEL).Infof("Set() key: %s, value: %s", key, value)
+	lookup := r.validateKey(key)
+	/* step: if the key was set with a ttl, we release it from the session so it no longer expires */
+	query := url.Values{}
+	session, found := r.session(lookup)
+	if found {
+		query.Set("release", session)
+	}
+	if _, _, err := r.request("PUT", lookup, query, bytes.NewBufferString(value)); err != nil {
+		glog.Errorf("Failed to set the key: %s, error: %s", key, err)
+		return err
+	}
+	if found {
+		r.destroySession(lookup, session)
+	}
+	return nil
+}
+
+/*
+Sets the key held by a session with the ttl, the session deletes the key when it expires; consul
+does not accept a ttl below ten seconds and may take up to twice the ttl to expire the session
+*/
+func (r *ConsulStoreClient) SetTTL(key string, value string, ttl time.Duration) error {
+	glog.V(VERBOSE_LEVEL).Infof("SetTTL() key: %s, value: %s, ttl: %s", key, value, ttl)
+	lookup := r.validateKey(key)
+	/* step: reuse the session holding the key if it's still alive */
+	session, found := r.session(lookup)
+	if found {
+		if err := r.renewSession(lookup, session); err != nil {
+			found = false
+		}
+	}
+	if !found {
+		var err error
+		if session, err = r.createSession(lookup, ttl); err != nil {
+			glog.Errorf("Failed to create a session for the key: %s, error: %s", key, err)
+			return err
+		}
+	}
+	query := url.Values{}
+	query.Set("acquire", session)
+	response, _, err := r.request("PUT", lookup, query, bytes.NewBufferString(value))
+	if err == nil && strings.TrimSpace(string(response)) != "true" {
+		err = errors.New("the key: " + key + " is held by another session")
+	}
+	if err != nil {
+		glog.Errorf("Failed to set the key: %s, error: %s", key, err)
+		r.destroySession(lookup, session)
+		return err
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.sessions[lookup] = session
+	return nil
+}
+
+/*
+Sets the key only if its modify index is unchanged since the index was read, or it does not exist
+if the index is zero; the check and the write are made in a transaction as a check and set can't
+acquire the key for a session
+*/
+func (r *ConsulStoreClient) CompareAndSet(key string, value string, index uint64, ttl time.Duration) error {
+	glog.V(VERBOSE_LEVEL).Infof("CompareAndSet() key: %s, value: %s, index: %d, ttl: %s", key, value, index, ttl)
+	lookup := r.validateKey(key)
+	check := consulTxnKV{Verb: "check-index", Key: lookup, Index: index}
+	if index == 0 {
+		check = consulTxnKV{Verb: "check-not-exists", Key: lookup}
+	}
+	write := consulTxnKV{Verb: "set", Key: lookup, Value: []byte(value)}
+	session, found := r.session(lookup)
+	created := false
+	if ttl > 0 {
+		/* step: reuse the session holding the key if it's still alive */
+		if found {
+			if err := r.renewSession(lookup, session); err != nil {
+				found = false
+			}
+		}
+		if !found {
+			var err error
+			if session, err = r.createSession(lookup, ttl); err != nil {
+				glog.Errorf("Failed to create a session for the key: %s, error: %s", key, err)
+				return err
+			}
+			created = true
+		}
+		write.Verb, write.Session = "lock", session
+	} else if found {
+		/* step: release the key from the session so it no longer expires */
+		write.Verb, write.Session = "unlock", session
+	}
+	request, _ := json.Marshal([]consulTxnOp{{KV: check}, {KV: write}})
+	if _, _, err := r.call("PUT", CONSUL_TXN, nil, bytes.NewReader(request)); err != nil {
+		if err != KeyModifiedErr {
+			glog.Errorf("Failed to compare and set the key: %s, error: %s", key, err)
+		}
+		if created {
+			r.destroySession(lookup, session)
+		}
+		return err
+	}
+	switch {
+	case ttl > 0:
+		r.Lock()
+		defer r.Unlock()
+		r.sessions[lookup] = session
+	case found:
+		r.destroySession(lookup, session)
+	}
+	return nil
+}
+
+func (r *ConsulStoreClient) Refresh(key string, ttl time.Duration) error {
+	glog.V(VERBOSE_LEVEL).Infof("Refresh() key: %s, ttl: %s", key, ttl)
+	lookup := r.validateKey(key)
+	session, found := r.session(lookup)
+	if !found {
+		return KeyNotFoundErr
+	}
+	if err := r.renewSession(lookup, session); err != nil {
+		if err != KeyNotFoundErr {
+			glog.Errorf("Failed to refresh the key: %s, error: %s", key, err)
+		}
+		return err
+	}
+	return nil
+}
+
+/* retrieves the session holding the key, if any */
+func (r *ConsulStoreClient) session(key string) (string, bool) {
+	r.RLock()
+	defer r.RUnlock()
+	session, found := r.sessions[key]
+	return session, found
+}
+
+/* creates a session with the ttl which deletes the keys it holds when it expires */
+func (r *ConsulStoreClient) createSession(key string, ttl time.Duration) (string, error) {
+	seconds := ttlSeconds(ttl)
+	if seconds < CONSUL_MIN_TTL {
+		seconds = CONSUL_MIN_TTL
+	}
+	request, _ := json.Marshal(map[string]string{
+		"Name":      "config-hook: " + key,
+		"TTL":       fmt.Sprintf("%ds", seconds),
+		"Behavior":  "delete",
+		"LockDelay": "0s",
+	})
+	response, _, err := r.call("PUT", CONSUL_SESSION+"create", nil, bytes.NewReader(request))
+	if err != nil {
+		return "", err
+	}
+	var session struct {
+		ID string
+	}
+	if err := json.Unmarshal(response, &session); err != nil {
+		return "", err
+	}
+	return session.ID, nil
+}
+
+/* renews the session holding the key, KeyNotFoundErr if the session has expired */
+func (r *ConsulStoreClient) renewSession(key, session string) error {
+	if _, _, err := r.call("PUT", CONSUL_SESSION+"renew/"+session, nil, nil); err != nil {
+		if err == KeyNotFoundErr {
+			r.forgetSession(key, session)
+		}
+		return err
+	}
+	return nil
+}
+
+/* destroys the session holding the key, any keys still held by the session are deleted */
+func (r *ConsulStoreClient) destroySession(key, session string) {
+	if _, _, err := r.call("PUT", CONSUL_SESSION+"destroy/"+session, nil, nil); err != nil {
+		glog.Warningf("Failed to destroy the session: %s for the key: %s, error: %s", session, key, err)
+	}
+	r.forgetSession(key, session)
+}
+
+func (r *ConsulStoreClient) forgetSession(key, session string) {
+	r.Lock()
+	defer r.Unlock()
+	if r.sessions[key] == session {
+		delete(r.sessions, key)
+	}
+}
+
+/* destroys the sessions of the key and anything beneath it */
+func (r *ConsulStoreClient) destroySessions(path string) {
+	r.RLock()
+	sessions := make(map[string]string, 0)
+	for key, session := range r.sessions {
+		if key == path || path == "" || strings.HasPrefix(key, path+"/") {
+			sessions[key] = session
+		}
+	}
+	r.RUnlock()
+	for key, session := range sessions {
+		r.destroySession(key, session)
+	}
+}
+
+func (r *ConsulStoreClient) Delete(key string) error {
+	glog.V(VERBOSE_LEVEL).Infof("Delete() deleting the key: %s", key)
+	if _, _, err := r.request("DELETE", r.validateKey(key), nil, nil); err != nil {
+		glog.Errorf("Delete() failed to delete key: %s, error: %s", key, err)
+		return err
+	}
+	r.destroySessions(r.validateKey(key))
+	return nil
+}
+
+func (r *ConsulStoreClient) RemovePath(path string) error {
+	glog.V(VERBOSE_LEVEL).Infof("RemovePath() deleting the path: %s", path)
+	query := url.Values{}
+	query.Set("recurse", "")
+	if _, _, err := r.request("DELETE", r.validateKey(path), query, nil); err != nil {
+		glog.Errorf("RemovePath() failed to delete key: %s, error: %s", path, err)
+		return err
+	}
+	r.destroySessions(r.validateKey(path))
+	return nil
+}
+
+func (r *ConsulStoreClient) List(path string) ([]*Node, error) {
+	key := r.validateKey(path)
+	glog.V(VERBOSE_LEVEL).Infof("List() path: %s", key)
+	query := url.Values{}
+	query.Set("recurse", "")
+	prefix := key + "/"
+	if key == "" {
+		prefix = ""
+	}
+	entries, _, err := r.getKV(prefix, query)
+	if err != nil {
+		glog.Errorf("List() failed to get path: %s, error: %s", key, err)
+		if err == KeyNotFoundErr {
+			return nil, InvalidDirectoryErr
+		}
+		return nil, err
+	}
+	return r.children(key, entries), nil
+}
+
+// Generates the immediate children of a path from a recursive listing, emulating directories
+//	path:		the path being listed
+//	entries:	all the keys under the path
+func (r *ConsulStoreClient) children(path string, entries []*consulKV) []*Node {
+	list := make([]*Node, 0)
+	directories := make(map[string]bool, 0)
+	prefix := path + "/"
+	if path == "" {
+		prefix = ""
+	}
+	for _, entry := range entries {
+		name := strings.TrimPrefix(entry.Key, prefix)
+		if name == "" {
+			continue
+		}
+		if index := strings.Index(name, "/"); index >= 0 {
+			directory := prefix + name[:index]
+			if !directories[directory] {
+				directories[directory] = true
+				list = append(list, &Node{Path: "/" + directory, Directory: true})
+			}
+			continue
+		}
+		list = append(list, r.createNode(entry))
+	}
+	return list
+}
+
+func (r *ConsulStoreClient) Paths(path string, paths *[]string) ([]string, error) {
+	query := url.Values{}
+	query.Set("keys", "")
+	prefix := r.validateKey(path)
+	if prefix != "" {
+		prefix += "/"
+	}
+	response, _, err := r.request("GET", prefix, query, nil)
+	if err == KeyNotFoundErr {
+		return *paths, nil
+	}
+	if err != nil {
+		return nil, errors.New("Unable to complete walking the tree" + err.Error())
+	}
+	keys := make([]string, 0)
+	if err := json.Unmarshal(response, &keys); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		// step: consul directory placeholders end with a slash
+		if strings.HasSuffix(key, "/") {
+			continue
+		}
+		*paths = append(*paths, "/"+key)
+	}
+	return *paths, nil
+}
+
+func (r *ConsulStoreClient) getKV(key string, query url.Values) ([]*consulKV, uint64, error) {
+	response, index, err := r.request("GET", key, query, nil)
+	if err != nil {
+		return nil, index, err
+	}
+	entries := make([]*consulKV, 0)
+	if err := json.Unmarshal(response, &entries); err != nil {
+		return nil, index, err
+	}
+	if len(entries) <= 0 {
+		return nil, index, KeyNotFoundErr
+	}
+	return entries, index, nil
+}
+
+// Performs a request against the consul kv api
+//	method:		the http method
+//	key:		the key in the kv store
+//	query:		any query parameters for the request
+//	body:		the content of the request, if any
+func (r *ConsulStoreClient) request(method, key string, query url.Values, body io.Reader) ([]byte, uint64, error) {
+	return r.call(method, CONSUL_KV_PATH+key, query, body)
+}
+
+// Performs a request against the consul api, trying each of the hosts in turn
+//	method:		the http method
+//	uri:		the path of the api call, i.e. /v1/kv/key
+//	query:		any query parameters for the request
+//	body:		the content of the request, if any
+func (r *ConsulStoreClient) call(method, uri string, query url.Values, body io.Reader) ([]byte, uint64, error) {
+	var content []byte
+	if body != nil {
+		content, _ = ioutil.ReadAll(body)
+	}
+	var err error
+	for _, host := range r.hosts {
+		location := host + uri
+		if len(query) > 0 {
+			location += "?" + query.Encode()
+		}
+		var request *http.Request
+		request, err = http.NewRequest(method, location, bytes.NewReader(content))
+		if err != nil {
+			return nil, 0, err
+		}
+		if config.Options.Consul.Token != "" {
+			request.Header.Set(CONSUL_TOKEN, config.Options.Consul.Token)
+		}
+		var response *http.Response
+		response, err = r.client.Do(request)
+		if err != nil {
+			glog.V(VERBOSE_LEVEL).Infof("Failed to connect to consul host: %s, error: %s", host, err)
+			continue
+		}
+		defer response.Body.Close()
+		index, _ := strconv.ParseUint(response.Header.Get(CONSUL_INDEX), 10, 64)
+		data, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, index, err
+		}
+		switch response.StatusCode {
+		case http.StatusOK:
+			return data, index, nil
+		case http.StatusNotFound:
+			return nil, index, KeyNotFoundErr
+		case http.StatusConflict:
+			/* step: a transaction has been rolled back */
+			return nil, index, KeyModifiedErr
+		default:
+			return nil, index, fmt.Errorf("consul returned status: %d, %s", response.StatusCode, strings.TrimSpace(string(data)))
+		}
+	}
+	return nil, 0, err
+}
+
+func (r *ConsulStoreClient) createNode(entry *consulKV) *Node {
+	node := &Node{}
+	node.Path = "/" + strings.TrimSuffix(entry.Key, "/")
+	node.Index = entry.ModifyIndex
+	if strings.HasSuffix(entry.Key, "/") {
+		node.Directory = true
+	} else {
+		node.Value = string(entry.Value)
+	}
+	return node
+}
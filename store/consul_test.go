@@ -0,0 +1,455 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/* a minimal in memory implementation of the consul kv api */
+type fakeConsul struct {
+	sync.Mutex
+	index   uint64
+	kv      map[string]*consulKV
+	changed chan bool
+	/* the live sessions and the keys they hold */
+	sessions map[string]bool
+	holders  map[string]string
+	created  int
+}
+
+func newFakeConsul() *fakeConsul {
+	return &fakeConsul{
+		index:    1,
+		kv:       make(map[string]*consulKV, 0),
+		changed:  make(chan bool),
+		sessions: make(map[string]bool, 0),
+		holders:  make(map[string]string, 0),
+	}
+}
+
+func (r *fakeConsul) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if strings.HasPrefix(req.URL.Path, CONSUL_SESSION) {
+		r.session(w, req)
+		return
+	}
+	if req.URL.Path == CONSUL_TXN {
+		r.txn(w, req)
+		return
+	}
+	key := strings.TrimPrefix(req.URL.Path, CONSUL_KV_PATH)
+	query := req.URL.Query()
+	_, recurse := query["recurse"]
+	switch req.Method {
+	case "PUT":
+		value, _ := ioutil.ReadAll(req.Body)
+		r.Lock()
+		session, holder := query.Get("acquire"), r.holders[key]
+		if session != "" && !r.sessions[session] {
+			r.Unlock()
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if session != "" && holder != "" && holder != session {
+			r.Unlock()
+			w.Write([]byte("false"))
+			return
+		}
+		if session != "" {
+			r.holders[key] = session
+		}
+		if release := query.Get("release"); release != "" && holder == release {
+			delete(r.holders, key)
+		}
+		r.Unlock()
+		r.update(func() {
+			r.kv[key] = &consulKV{Key: key, Value: value, ModifyIndex: r.index}
+		})
+		w.Write([]byte("true"))
+	case "DELETE":
+		r.update(func() {
+			for name, _ := range r.kv {
+				if name == key || (recurse && strings.HasPrefix(name, key)) {
+					delete(r.kv, name)
+				}
+			}
+		})
+		w.Write([]byte("true"))
+	case "GET":
+		// step: emulate the blocking query
+		if wait, _ := strconv.ParseUint(query.Get("index"), 10, 64); wait > 0 {
+			r.Lock()
+			current, changed := r.index, r.changed
+			r.Unlock()
+			if wait >= current {
+				select {
+				case <-changed:
+				case <-time.After(time.Second):
+				}
+			}
+		}
+		r.Lock()
+		defer r.Unlock()
+		w.Header().Set(CONSUL_INDEX, strconv.FormatUint(r.index, 10))
+		entries := make([]*consulKV, 0)
+		for name, entry := range r.kv {
+			if name == key || ((recurse || query["keys"] != nil) && strings.HasPrefix(name, key)) {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) <= 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if _, found := query["keys"]; found {
+			keys := make([]string, 0)
+			for _, entry := range entries {
+				keys = append(keys, entry.Key)
+			}
+			sort.Strings(keys)
+			json.NewEncoder(w).Encode(keys)
+			return
+		}
+		json.NewEncoder(w).Encode(entries)
+	}
+}
+
+/* handles the session api, create, renew and destroy */
+func (r *fakeConsul) session(w http.ResponseWriter, req *http.Request) {
+	action := strings.TrimPrefix(req.URL.Path, CONSUL_SESSION)
+	switch {
+	case action == "create":
+		r.Lock()
+		r.created++
+		id := "session-" + strconv.Itoa(r.created)
+		r.sessions[id] = true
+		r.Unlock()
+		json.NewEncoder(w).Encode(map[string]string{"ID": id})
+	case strings.HasPrefix(action, "renew/"):
+		r.Lock()
+		defer r.Unlock()
+		if !r.sessions[strings.TrimPrefix(action, "renew/")] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("[]"))
+	case strings.HasPrefix(action, "destroy/"):
+		r.expire(strings.TrimPrefix(action, "destroy/"))
+		w.Write([]byte("true"))
+	}
+}
+
+/* handles the transaction api, rolling back if any of the checks, locks or unlocks fail */
+func (r *fakeConsul) txn(w http.ResponseWriter, req *http.Request) {
+	operations := make([]consulTxnOp, 0)
+	if err := json.NewDecoder(req.Body).Decode(&operations); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.Lock()
+	for _, operation := range operations {
+		kv := operation.KV
+		entry, found := r.kv[kv.Key]
+		holder := r.holders[kv.Key]
+		failed := false
+		switch kv.Verb {
+		case "check-index":
+			failed = !found || entry.ModifyIndex != kv.Index
+		case "check-not-exists":
+			failed = found
+		case "lock":
+			failed = !r.sessions[kv.Session] || (holder != "" && holder != kv.Session)
+		case "unlock":
+			failed = holder != kv.Session
+		}
+		if failed {
+			r.Unlock()
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"Results":null,"Errors":[{"What":"failed"}]}`))
+			return
+		}
+	}
+	r.Unlock()
+	r.update(func() {
+		for _, operation := range operations {
+			kv := operation.KV
+			switch kv.Verb {
+			case "lock":
+				r.holders[kv.Key] = kv.Session
+			case "unlock":
+				delete(r.holders, kv.Key)
+			case "set":
+			default:
+				continue
+			}
+			r.kv[kv.Key] = &consulKV{Key: kv.Key, Value: kv.Value, ModifyIndex: r.index}
+		}
+	})
+	w.Write([]byte(`{"Results":[],"Errors":null}`))
+}
+
+/* removes the session, deleting the keys it holds */
+func (r *fakeConsul) expire(session string) {
+	r.update(func() {
+		delete(r.sessions, session)
+		for key, holder := range r.holders {
+			if holder == session {
+				delete(r.holders, key)
+				delete(r.kv, key)
+			}
+		}
+	})
+}
+
+func (r *fakeConsul) update(change func()) {
+	r.Lock()
+	defer r.Unlock()
+	r.index++
+	change()
+	close(r.changed)
+	r.changed = make(chan bool)
+}
+
+func newTestConsulClient(t *testing.T) (Store, NodeUpdateChannel, *httptest.Server) {
+	client, channel, _, server := newTestConsulFake(t)
+	return client, channel, server
+}
+
+func newTestConsulFake(t *testing.T) (Store, NodeUpdateChannel, *fakeConsul, *httptest.Server) {
+	fake := newFakeConsul()
+	server := httptest.NewServer(fake)
+	location, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+	location.Scheme = "consul"
+	channel := make(NodeUpdateChannel, 10)
+	client, err := NewConsulStoreClient(location, channel)
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+	return client, channel, fake, server
+}
+
+func TestConsulSetGet(t *testing.T) {
+	client, _, server := newTestConsulClient(t)
+	defer server.Close()
+	assert.Nil(t, client.Set("/test/key", "value"))
+	node, err := client.Get("/test/key")
+	assert.Nil(t, err)
+	assert.Equal(t, "/test/key", node.Path)
+	assert.Equal(t, "value", node.Value)
+	assert.True(t, node.IsFile())
+	_, err = client.Get("/test/missing")
+	assert.Equal(t, KeyNotFoundErr, err)
+}
+
+func TestConsulExistsDelete(t *testing.T) {
+	client, _, server := newTestConsulClient(t)
+	defer server.Close()
+	assert.Nil(t, client.Set("/test/key", "value"))
+	found, err := client.Exists("/test/key")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Nil(t, client.Delete("/test/key"))
+	found, err = client.Exists("/test/key")
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
+
+func TestConsulDirectory(t *testing.T) {
+	client, _, server := newTestConsulClient(t)
+	defer server.Close()
+	assert.Nil(t, client.Set("/test/dir/key", "value"))
+	found, err := client.Exists("/test/dir")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	found, err = client.Exists("/test/di")
+	assert.Nil(t, err)
+	assert.False(t, found)
+	node, err := client.Get("/test/dir")
+	assert.Nil(t, err)
+	assert.Equal(t, "/test/dir", node.Path)
+	assert.True(t, node.IsDir())
+	_, err = client.Get("/test/di")
+	assert.Equal(t, KeyNotFoundErr, err)
+
+	assert.Nil(t, client.RemovePath("/test/dir"))
+	found, err = client.Exists("/test/dir")
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
+
+func TestConsulListPaths(t *testing.T) {
+	client, _, server := newTestConsulClient(t)
+	defer server.Close()
+	assert.Nil(t, client.Set("/test/one", "1"))
+	assert.Nil(t, client.Set("/test/two", "2"))
+	assert.Nil(t, client.Set("/test/dir/three", "3"))
+	list, err := client.List("/test")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(list))
+	directories := 0
+	for _, node := range list {
+		if node.IsDir() {
+			directories++
+			assert.Equal(t, "/test/dir", node.Path)
+		}
+	}
+	assert.Equal(t, 1, directories)
+
+	paths := make([]string, 0)
+	_, err = client.Paths("/test", &paths)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/test/dir/three", "/test/one", "/test/two"}, paths)
+
+	assert.Nil(t, client.RemovePath("/test"))
+	_, err = client.List("/test")
+	assert.NotNil(t, err)
+}
+
+func TestConsulWatch(t *testing.T) {
+	client, updates, server := newTestConsulClient(t)
+	defer server.Close()
+	defer client.Close()
+	assert.Nil(t, client.Set("/test/watch", "1"))
+	client.Watch("/test/watch")
+	// step: give the watcher a chance to take the baseline
+	time.Sleep(100 * time.Millisecond)
+	assert.Nil(t, client.Set("/test/watch", "2"))
+	select {
+	case event := <-updates:
+		assert.Equal(t, CHANGED, int(event.Operation))
+		assert.Equal(t, "/test/watch", event.Node.Path)
+		assert.Equal(t, "2", event.Node.Value)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "we timed out waiting for an event")
+	}
+	assert.Nil(t, client.Delete("/test/watch"))
+	select {
+	case event := <-updates:
+		assert.Equal(t, DELETED, int(event.Operation))
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "we timed out waiting for an event")
+	}
+	client.Unwatch("/test/watch")
+	assert.Nil(t, client.Set("/test/watch", "3"))
+	select {
+	case <-updates:
+		assert.Fail(t, "we should not have recieved an event here")
+	case <-time.After(1500 * time.Millisecond):
+	}
+}
+
+func TestConsulWatchIndex(t *testing.T) {
+	// step: a consul which never returns an index, so every blocking query returns immediately
+	var lock sync.Mutex
+	indexes := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		lock.Lock()
+		indexes = append(indexes, req.URL.Query().Get("index"))
+		lock.Unlock()
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	location, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+	client, err := NewConsulStoreClient(location, make(NodeUpdateChannel, 10))
+	assert.Nil(t, err)
+	consul := client.(*ConsulStoreClient)
+	consul.retry_min, consul.retry_max = 50*time.Millisecond, 100*time.Millisecond
+	client.Watch("/test/watch")
+	time.Sleep(500 * time.Millisecond)
+	client.Close()
+
+	lock.Lock()
+	defer lock.Unlock()
+	// step: we should have backed off rather than spinning, and never waited on index zero
+	assert.True(t, len(indexes) > 2 && len(indexes) < 12, "made %d queries", len(indexes))
+	for _, index := range indexes[1:] {
+		assert.Equal(t, "1", index)
+	}
+}
+
+func TestConsulTTL(t *testing.T) {
+	client, _, fake, server := newTestConsulFake(t)
+	defer server.Close()
+	assert.Nil(t, client.SetTTL("/test/ttl", "1", time.Minute))
+	assert.Nil(t, client.Refresh("/test/ttl", time.Minute))
+	// step: setting the key again reuses the session
+	assert.Nil(t, client.SetTTL("/test/ttl", "2", time.Minute))
+	assert.Equal(t, 1, len(fake.sessions))
+	node, err := client.Get("/test/ttl")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", node.Value)
+
+	// step: when the session expires the key is deleted and the refresh fails
+	fake.expire("session-1")
+	found, err := client.Exists("/test/ttl")
+	assert.Nil(t, err)
+	assert.False(t, found)
+	assert.Equal(t, KeyNotFoundErr, client.Refresh("/test/ttl", time.Minute))
+
+	// step: a key set without a ttl is released from the session
+	assert.Nil(t, client.SetTTL("/test/ttl", "3", time.Minute))
+	assert.Nil(t, client.Set("/test/ttl", "4"))
+	assert.Equal(t, 0, len(fake.sessions))
+	node, err = client.Get("/test/ttl")
+	assert.Nil(t, err)
+	assert.Equal(t, "4", node.Value)
+	assert.Equal(t, KeyNotFoundErr, client.Refresh("/test/ttl", time.Minute))
+}
+
+func TestConsulCompareAndSet(t *testing.T) {
+	client, _, fake, server := newTestConsulFake(t)
+	defer server.Close()
+	// step: an index of zero only creates the key
+	assert.Nil(t, client.CompareAndSet("/test/cas", "1", 0, 0))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet("/test/cas", "2", 0, 0))
+	node, err := client.Get("/test/cas")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", node.Value)
+	assert.True(t, node.Index > 0)
+	// step: the index must match the modify index of the key
+	assert.Nil(t, client.CompareAndSet("/test/cas", "2", node.Index, 0))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet("/test/cas", "3", node.Index, 0))
+	node, err = client.Get("/test/cas")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", node.Value)
+
+	// step: with a ttl the key is held by a session, a failed compare leaves no session behind
+	assert.Nil(t, client.CompareAndSet("/test/cas", "4", node.Index, time.Minute))
+	assert.Equal(t, 1, len(fake.sessions))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet("/test/other", "1", 1, time.Minute))
+	assert.Equal(t, 1, len(fake.sessions))
+	assert.Nil(t, client.Refresh("/test/cas", time.Minute))
+	// step: setting without a ttl releases the key from the session
+	node, err = client.Get("/test/cas")
+	assert.Nil(t, err)
+	assert.Nil(t, client.CompareAndSet("/test/cas", "5", node.Index, 0))
+	assert.Equal(t, 0, len(fake.sessions))
+	node, err = client.Get("/test/cas")
+	assert.Nil(t, err)
+	assert.Equal(t, "5", node.Value)
+	assert.Equal(t, KeyNotFoundErr, client.Refresh("/test/cas", time.Minute))
+}
@@ -15,13 +15,17 @@ package store
 
 import (
 	"errors"
-	"flag"
 	"fmt"
 	"net/url"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/gambol99/config-hook/config"
+
 	etcd "github.com/coreos/go-etcd/etcd"
 	"github.com/golang/glog"
 )
@@ -39,23 +43,18 @@ type EtcdStoreClient struct {
 	stop_channel chan bool
 	/* a map of keys presently being watched */
 	watchedKeys map[string]bool
+	/* the modified index of the nodes under the watched keys, used to resync */
+	known map[string]uint64
 	/* the channel used to send node updates */
 	update_channel chan NodeChange
 }
 
-/* etcd options for TLS support */
-var EtcdOptions struct {
-	cert_file, key_file, cacert_file string
-}
-
-func init() {
-	flag.StringVar(&EtcdOptions.cert_file, "etcd-cert", "", "the etcd certificate file (optional)")
-	flag.StringVar(&EtcdOptions.key_file, "etcd-keycert", "", "the etcd key certificate file (optional)")
-	flag.StringVar(&EtcdOptions.cacert_file, "etcd-cacert", "", "the etcd ca certificate file (optional)")
-}
-
 const (
-	ETCD_PREFIX = "etcd://"
+	ETCD_PREFIX        = "etcd://"
+	ETCD_KEY_NOT_FOUND = 100
+	ETCD_TEST_FAILED   = 101
+	ETCD_NODE_EXISTS   = 105
+	ETCD_INDEX_CLEARED = 401
 )
 
 func NewEtcdStoreClient(location *url.URL, channel NodeUpdateChannel) (Store, error) {
@@ -65,15 +64,16 @@ func NewEtcdStoreClient(location *url.URL, channel NodeUpdateChannel) (Store, er
 	store.stop_channel = make(chan bool)
 	store.update_channel = channel
 	store.watchedKeys = make(map[string]bool, 0)
+	store.known = make(map[string]uint64, 0)
 	store.base_key = "/"
 
 	glog.Infof("Creating a Etcd Agent for K/V Store, hosts: %s", store.hosts)
 
 	/* step: create the etcd client */
-	if EtcdOptions.cacert_file != "" {
-		client, err := etcd.NewTLSClient(store.hosts, EtcdOptions.cert_file, EtcdOptions.key_file, EtcdOptions.cacert_file)
+	if config.Options.Etcd.CACert_File != "" {
+		client, err := etcd.NewTLSClient(store.hosts, config.Options.Etcd.Cert_File, config.Options.Etcd.Key_File, config.Options.Etcd.CACert_File)
 		if err != nil {
-			glog.Errorf("Failed to create a TLS connection to etcd: %s, error: %s", *location, err)
+			glog.Errorf("Failed to create a TLS connection to etcd: %s, error: %s", location, err)
 			return nil, err
 		}
 		store.client = client
@@ -87,58 +87,173 @@ func NewEtcdStoreClient(location *url.URL, channel NodeUpdateChannel) (Store, er
 
 func (r *EtcdStoreClient) processEvents() {
 	glog.V(VERBOSE_LEVEL).Infof("Starting the event watcher for the etcd clinet, channel: %v", r.update_channel)
-	/* the kill switch for the goroutine */
-	kill_off := false
-
-	/* routine: waits on the shutdown signal for the client and flicks the kill switch */
-	go func() {
-		glog.V(VERBOSE_LEVEL).Infof("Waiting on a shutdown signal from consumer, channel: %v", r.update_channel)
-		/* step: wait for the shutdown signal */
-		<-r.stop_channel
-		/* @perhaps : we could speed up the take down by using a stop channel on the watch? */
-		glog.V(VERBOSE_LEVEL).Infof("Flicking the kill switch for watcher, channel: %v", r.update_channel)
-		kill_off = true
-	}()
 
-	/* routine: loops around watching until flick the switch */
+	/* routine: loops around watching until the stop channel is closed */
 	go func() {
-		/* step: set the index to zero for now */
+		/* step: the index to watch from, zero until we have synced with the cluster */
 		wait_index := uint64(0)
-		/* step: look until we hit the kill switch */
+		/* step: look until we are asked to stop */
 		for {
-			if kill_off {
+			/* step: sync with the cluster, on start or when the history has been cleared */
+			if wait_index == 0 {
+				index, err := r.resync()
+				if err != nil {
+					glog.Errorf("Failed to resync the watched keys, error: %s", err)
+					watchReconnects.Inc("etcd")
+					if !r.wait(3 * time.Second) {
+						break
+					}
+					continue
+				}
+				wait_index = index + 1
+			}
+			/* step: apply a watch on the key and wait, the stop channel cancels the request */
+			response, err := r.client.Watch(r.base_key, wait_index, true, nil, r.stop_channel)
+			if err == etcd.ErrWatchStoppedByUser {
 				break
 			}
-			/* step: apply a watch on the key and wait */
-			response, err := r.client.Watch(r.base_key, wait_index, true, nil, nil)
-			if err != nil {
-				glog.Errorf("Failed to attempting to watch the key: %s, error: %s", r.base_key, err)
-				time.Sleep(3 * time.Second)
-				wait_index = uint64(0)
+			if isEtcdError(err, ETCD_INDEX_CLEARED) {
+				glog.Warningf("The watch index: %d has been cleared from the etcd history, resyncing", wait_index)
+				wait_index = 0
 				continue
 			}
-
-			/* step: have we been requested to quit */
-			if kill_off {
+			if err != nil {
+				/* step: we keep the index, etcd will replay anything we missed while reconnecting */
+				glog.Errorf("Failed to attempting to watch the key: %s, index: %d, error: %s", r.base_key, wait_index, err)
+				watchReconnects.Inc("etcd")
+				if !r.wait(3 * time.Second) {
+					break
+				}
 				continue
 			}
 			/* step: update the wait index */
 			wait_index = response.Node.ModifiedIndex + 1
 
 			/* step: cool - we have a notification - lets check if this key is being watched */
-			go r.processNodeChange(response)
+			r.processNodeChange(response)
 		}
 		glog.V(VERBOSE_LEVEL).Infof("Exitted the k/v watcher routine, channel: %v", r.update_channel)
 	}()
 }
 
+/* waits for the duration, returning false if the client was closed in the meantime */
+func (r *EtcdStoreClient) wait(duration time.Duration) bool {
+	select {
+	case <-time.After(duration):
+		return true
+	case <-r.stop_channel:
+		return false
+	}
+}
+
+/*
+Retrieves the watched keys from the cluster and sends events for any differences from
+what we last knew, returning the lowest cluster index of the reads; the keys are read one
+at a time, so the watch must resume from before the first read to replay any writes which
+landed between them
+*/
+func (r *EtcdStoreClient) resync() (uint64, error) {
+	r.Lock()
+	defer r.Unlock()
+	glog.V(VERBOSE_LEVEL).Infof("Resyncing %d watched keys with the cluster", len(r.watchedKeys))
+	/* step: retrieve the index of the cluster */
+	response, err := r.client.Get(r.base_key, false, false)
+	if err != nil {
+		return 0, err
+	}
+	index := response.EtcdIndex
+	current := make(map[string]*etcd.Node, 0)
+	for key, _ := range r.watchedKeys {
+		response, err := r.client.Get(key, false, true)
+		if isEtcdError(err, ETCD_KEY_NOT_FOUND) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		flattenNodes(response.Node, current)
+		/* step: the members may lag one another, so keep the lowest index seen */
+		if response.EtcdIndex < index {
+			index = response.EtcdIndex
+		}
+	}
+	/* step: send events for the differences */
+	for _, event := range diffNodes(r.known, current) {
+		glog.V(VERBOSE_LEVEL).Infof("Resync found the key: %s has changed, operation: %d", event.Node.Path, event.Operation)
+		r.sendEvent(event)
+	}
+	r.known = make(map[string]uint64, 0)
+	for path, node := range current {
+		r.known[path] = node.ModifiedIndex
+	}
+	return index, nil
+}
+
+/* adds the node and all the files beneath it to the map */
+func flattenNodes(node *etcd.Node, nodes map[string]*etcd.Node) {
+	if node == nil {
+		return
+	}
+	if !node.Dir {
+		nodes[node.Key] = node
+		return
+	}
+	for _, child := range node.Nodes {
+		flattenNodes(child, nodes)
+	}
+}
+
+/*
+Compares the modified index we knew for the files with those in the cluster, any new or
+modified files are CHANGED and any missing are DELETED
+*/
+func diffNodes(known map[string]uint64, current map[string]*etcd.Node) []NodeChange {
+	events := make([]NodeChange, 0)
+	paths := make([]string, 0)
+	for path, _ := range current {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		node := current[path]
+		if index, found := known[path]; !found || index != node.ModifiedIndex {
+			events = append(events, NodeChange{
+				Node:      Node{Path: node.Key, Value: node.Value},
+				Operation: CHANGED,
+			})
+		}
+	}
+	paths = make([]string, 0)
+	for path, _ := range known {
+		if _, found := current[path]; !found {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		events = append(events, NodeChange{
+			Node:      Node{Path: path},
+			Operation: DELETED,
+		})
+	}
+	return events
+}
+
+/* checks if the error is an etcd error with the code */
+func isEtcdError(err error, code int) bool {
+	if etcdErr, ok := err.(*etcd.EtcdError); ok && etcdErr.ErrorCode == code {
+		return true
+	}
+	return false
+}
+
 func (r *EtcdStoreClient) processNodeChange(response *etcd.Response) {
+	r.Lock()
+	defer r.Unlock()
 	// step: are there any keys being watched
 	if len(r.watchedKeys) <= 0 {
 		return
 	}
-	r.RLock()
-	defer r.RUnlock()
 	// step: iterate the list and find out if our key is being watched
 	path := response.Node.Key
 	glog.V(VERBOSE_LEVEL).Infof("Checking if key: %s is being watched", path)
@@ -151,26 +266,44 @@ func (r *EtcdStoreClient) processNodeChange(response *etcd.Response) {
 			event.Node.Value = response.Node.Value
 			event.Node.Directory = response.Node.Dir
 			switch response.Action {
-			case "set":
+			case "set", "create", "update", "compareAndSwap":
 				event.Operation = CHANGED
-			case "delete":
+				r.known[path] = response.Node.ModifiedIndex
+			case "delete", "expire", "compareAndDelete":
 				event.Operation = DELETED
+				r.forget(path)
+			default:
+				glog.V(VERBOSE_LEVEL).Infof("Ignoring the action: %s on key: %s", response.Action, path)
+				return
 			}
-			// step: send the event upstream via the channel
-			go func() {
-				r.update_channel <- event
-			}()
+			r.sendEvent(event)
 			return
 		}
 	}
 	glog.V(VERBOSE_LEVEL).Infof("The key: %s is presently not being watched, we can ignore for now", path)
 }
 
-func (r EtcdStoreClient) parseHostsURL(location *url.URL) []string {
+/* removes the path and anything beneath it from the known nodes */
+func (r *EtcdStoreClient) forget(path string) {
+	for key, _ := range r.known {
+		if key == path || strings.HasPrefix(key, path+"/") {
+			delete(r.known, key)
+		}
+	}
+}
+
+/* sends the event upstream via the channel */
+func (r *EtcdStoreClient) sendEvent(event NodeChange) {
+	go func() {
+		r.update_channel <- event
+	}()
+}
+
+func (r *EtcdStoreClient) parseHostsURL(location *url.URL) []string {
 	hosts := make([]string, 0)
 	/* step: determine the protocol */
 	protocol := "http"
-	if EtcdOptions.cacert_file != "" {
+	if config.Options.Etcd.CACert_File != "" {
 		protocol = "https"
 	}
 	for _, host := range strings.Split(location.Host, ",") {
@@ -181,7 +314,7 @@ func (r EtcdStoreClient) parseHostsURL(location *url.URL) []string {
 
 func (r *EtcdStoreClient) Close() {
 	glog.Infof("Shutting down the etcd client")
-	r.stop_channel <- true
+	close(r.stop_channel)
 }
 
 func (r *EtcdStoreClient) Watch(key string) {
@@ -193,6 +326,14 @@ func (r *EtcdStoreClient) Watch(key string) {
 	} else {
 		glog.V(VERBOSE_LEVEL).Infof("Adding a watch on the key: %s", key)
 		r.watchedKeys[key] = true
+		/* step: remember the present state of the key so a resync can spot any changes */
+		if response, err := r.client.Get(key, false, true); err == nil {
+			nodes := make(map[string]*etcd.Node, 0)
+			flattenNodes(response.Node, nodes)
+			for path, node := range nodes {
+				r.known[path] = node.ModifiedIndex
+			}
+		}
 	}
 }
 
@@ -200,6 +341,7 @@ func (r *EtcdStoreClient) Unwatch(key string) {
 	r.Lock()
 	defer r.Unlock()
 	delete(r.watchedKeys, key)
+	r.forget(key)
 }
 
 func (r *EtcdStoreClient) validateKey(key string) string {
@@ -235,6 +377,18 @@ func (r *EtcdStoreClient) getRaw(key string) (*etcd.Response, error) {
 	return response, nil
 }
 
+func (r *EtcdStoreClient) Exists(key string) (bool, error) {
+	glog.V(VERBOSE_LEVEL).Infof("Exists() key: %s", key)
+	if _, err := r.client.Get(key, false, false); err != nil {
+		if isEtcdError(err, ETCD_KEY_NOT_FOUND) {
+			return false, nil
+		}
+		glog.Errorf("Failed to check the key: %s exists, error: %s", key, err)
+		return false, err
+	}
+	return true, nil
+}
+
 func (r *EtcdStoreClient) Set(key string, value string) error {
 	glog.V(VERBOSE_LEVEL).Infof("Set() key: %s, value: %s", key, value)
 	_, err := r.client.Set(key, value, uint64(0))
@@ -245,6 +399,60 @@ func (r *EtcdStoreClient) Set(key string, value string) error {
 	return nil
 }
 
+func (r *EtcdStoreClient) SetTTL(key string, value string, ttl time.Duration) error {
+	glog.V(VERBOSE_LEVEL).Infof("SetTTL() key: %s, value: %s, ttl: %s", key, value, ttl)
+	if _, err := r.client.Set(key, value, uint64(ttlSeconds(ttl))); err != nil {
+		glog.Errorf("Failed to set the key: %s, error: %s", key, err)
+		return err
+	}
+	return nil
+}
+
+/*
+Sets the key only if it has not been modified since the index was read, or creates it if the
+index is zero; a ttl of zero means the key does not expire
+*/
+func (r *EtcdStoreClient) CompareAndSet(key string, value string, index uint64, ttl time.Duration) error {
+	glog.V(VERBOSE_LEVEL).Infof("CompareAndSet() key: %s, value: %s, index: %d, ttl: %s", key, value, index, ttl)
+	var err error
+	if index == 0 {
+		_, err = r.client.Create(key, value, uint64(ttlSeconds(ttl)))
+	} else {
+		_, err = r.client.CompareAndSwap(key, value, uint64(ttlSeconds(ttl)), "", index)
+	}
+	if isEtcdError(err, ETCD_TEST_FAILED) || isEtcdError(err, ETCD_NODE_EXISTS) || isEtcdError(err, ETCD_KEY_NOT_FOUND) {
+		return KeyModifiedErr
+	}
+	if err != nil {
+		glog.Errorf("Failed to compare and set the key: %s, error: %s", key, err)
+		return err
+	}
+	return nil
+}
+
+/*
+Refreshes the ttl of the key without changing the value; a refresh does not notify any watchers,
+which requires etcd 2.3 or above
+*/
+func (r *EtcdStoreClient) Refresh(key string, ttl time.Duration) error {
+	glog.V(VERBOSE_LEVEL).Infof("Refresh() key: %s, ttl: %s", key, ttl)
+	values := url.Values{}
+	values.Set("ttl", strconv.FormatInt(ttlSeconds(ttl), 10))
+	request := etcd.NewRawRequest("PUT", path.Join("keys", key)+"?refresh=true&prevExist=true", values, nil)
+	response, err := r.client.SendRequest(request)
+	if err == nil {
+		_, err = response.Unmarshal()
+	}
+	if isEtcdError(err, ETCD_KEY_NOT_FOUND) {
+		return KeyNotFoundErr
+	}
+	if err != nil {
+		glog.Errorf("Failed to refresh the key: %s, error: %s", key, err)
+		return err
+	}
+	return nil
+}
+
 func (r *EtcdStoreClient) Delete(key string) error {
 	glog.V(VERBOSE_LEVEL).Infof("Delete() deleting the key: %s", key)
 	if _, err := r.client.Delete(key, false); err != nil {
@@ -301,6 +509,7 @@ func (e *EtcdStoreClient) Paths(path string, paths *[]string) ([]string, error)
 func (r *EtcdStoreClient) createNode(response *etcd.Node) *Node {
 	node := &Node{}
 	node.Path = response.Key
+	node.Index = response.ModifiedIndex
 	if response.Dir == false {
 		node.Directory = false
 		node.Value = response.Value
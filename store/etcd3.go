@@ -0,0 +1,673 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gambol99/config-hook/config"
+
+	"github.com/golang/glog"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+A client for the etcd v3 api; the v3 keyspace is flat, so the directories are emulated with
+the key prefixes
+*/
+type Etcd3StoreClient struct {
+	/* a lock for the watcher map */
+	sync.RWMutex
+	/* a list of etcd hosts */
+	hosts []string
+	/* the etcd v3 client */
+	client *clientv3.Client
+	/* a map of keys presently being watched and the channel to stop them */
+	watchedKeys map[string]chan bool
+	/* a map of the keys set with a ttl and the lease attached to them */
+	leases map[string]clientv3.LeaseID
+	/* the time to wait before re-establishing a failed watch */
+	retry_wait time.Duration
+	/* the channel used to send node updates */
+	update_channel chan NodeChange
+}
+
+const (
+	ETCD3_PREFIX          = "etcd3://"
+	ETCD3_RETRY_WAIT      = 3 * time.Second
+	ETCD3_DIAL_TIMEOUT    = 5 * time.Second
+	ETCD3_REQUEST_TIMEOUT = 10 * time.Second
+)
+
+/* an error returned when the watch was stopped while resyncing */
+var etcd3StoppedErr = errors.New("the watch has been stopped")
+
+func NewEtcd3StoreClient(location *url.URL, channel NodeUpdateChannel) (Store, error) {
+	store := new(Etcd3StoreClient)
+	store.hosts = store.parseHostsURL(location)
+
+	glog.Infof("Creating a Etcd v3 Agent for K/V Store, hosts: %s", store.hosts)
+
+	/* step: create the etcd client */
+	options := clientv3.Config{
+		Endpoints:   store.hosts,
+		DialTimeout: ETCD3_DIAL_TIMEOUT,
+	}
+	if config.Options.Etcd.CACert_File != "" {
+		tls_config, err := newTLSConfig(config.Options.Etcd)
+		if err != nil {
+			glog.Errorf("Failed to create a TLS connection to etcd: %s, error: %s", location, err)
+			return nil, err
+		}
+		options.TLS = tls_config
+	}
+	return newEtcd3StoreClient(options, channel)
+}
+
+/* creates the client from the etcd options, the connection is established in the background */
+func newEtcd3StoreClient(options clientv3.Config, channel NodeUpdateChannel) (*Etcd3StoreClient, error) {
+	client, err := clientv3.New(options)
+	if err != nil {
+		glog.Errorf("Failed to create the etcd v3 client, hosts: %s, error: %s", options.Endpoints, err)
+		return nil, err
+	}
+	return &Etcd3StoreClient{
+		hosts:          options.Endpoints,
+		client:         client,
+		watchedKeys:    make(map[string]chan bool, 0),
+		leases:         make(map[string]clientv3.LeaseID, 0),
+		retry_wait:     ETCD3_RETRY_WAIT,
+		update_channel: channel,
+	}, nil
+}
+
+func (r *Etcd3StoreClient) parseHostsURL(location *url.URL) []string {
+	hosts := make([]string, 0)
+	/* step: determine the protocol */
+	protocol := "http"
+	if config.Options.Etcd.CACert_File != "" {
+		protocol = "https"
+	}
+	for _, host := range strings.Split(location.Host, ",") {
+		hosts = append(hosts, fmt.Sprintf("%s://%s", protocol, host))
+	}
+	return hosts
+}
+
+func (r *Etcd3StoreClient) Close() {
+	glog.Infof("Shutting down the etcd v3 client")
+	r.Lock()
+	defer r.Unlock()
+	for key, stop := range r.watchedKeys {
+		close(stop)
+		delete(r.watchedKeys, key)
+	}
+	r.client.Close()
+}
+
+func (r *Etcd3StoreClient) Watch(key string) {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	// step: we check if the key is being watched and if not add it
+	if _, found := r.watchedKeys[key]; found {
+		glog.V(VERBOSE_LEVEL).Infof("Thy key: %s is already being wathed, skipping for now", key)
+		return
+	}
+	glog.V(VERBOSE_LEVEL).Infof("Adding a watch on the key: %s", key)
+	stop := make(chan bool)
+	r.watchedKeys[key] = stop
+	go r.watchKey(key, stop)
+}
+
+func (r *Etcd3StoreClient) Unwatch(key string) {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	if stop, found := r.watchedKeys[key]; found {
+		close(stop)
+		delete(r.watchedKeys, key)
+	}
+}
+
+// Watches the key and anything beneath it from the revision of the last change seen, so nothing
+// is missed while reconnecting; if the revision has been compacted the keys are read in full and
+// the differences from what was last seen are sent instead
+//	key:		the key being watched
+//	stop:		the channel closed when the watch should stop
+func (r *Etcd3StoreClient) watchKey(key string, stop chan bool) {
+	glog.V(VERBOSE_LEVEL).Infof("Starting the revision watcher for key: %s", key)
+	/* step: the revision of the files under the key, used to resync */
+	known := make(map[string]int64, 0)
+	revision := int64(0)
+	synced := false
+	for {
+		select {
+		case <-stop:
+			glog.V(VERBOSE_LEVEL).Infof("Exitted the watcher for key: %s", key)
+			return
+		default:
+		}
+		/* step: sync with the cluster, on start or when the revision has been compacted */
+		if !synced {
+			current, err := r.resync(key, known, stop, revision > 0)
+			if err == etcd3StoppedErr {
+				return
+			}
+			if err != nil {
+				glog.Errorf("Failed to resync the watched key: %s, error: %s", key, err)
+				watchReconnects.Inc("etcd3")
+				if !r.wait(stop) {
+					return
+				}
+				continue
+			}
+			revision = current
+			synced = true
+		}
+		err := r.watchStream(key, revision+1, known, stop, func(current int64) {
+			revision = current
+		})
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		if err == rpctypes.ErrCompacted {
+			glog.Warningf("The watch revision: %d on key: %s has been compacted, resyncing", revision+1, key)
+			synced = false
+			continue
+		}
+		/* step: we keep the revision, the watch will replay anything we missed while reconnecting */
+		glog.Errorf("Failed to attempting to watch the key: %s, revision: %d, error: %s", key, revision+1, err)
+		watchReconnects.Inc("etcd3")
+		if !r.wait(stop) {
+			return
+		}
+	}
+}
+
+/* waits before retrying, returning false if the watch was stopped in the meantime */
+func (r *Etcd3StoreClient) wait(stop chan bool) bool {
+	select {
+	case <-time.After(r.retry_wait):
+		return true
+	case <-stop:
+		return false
+	}
+}
+
+/*
+Retrieves the files under the watched key and, if notify is set, sends events for any differences
+from what we last knew; returns the revision the snapshot was taken at
+*/
+func (r *Etcd3StoreClient) resync(key string, known map[string]int64, stop chan bool, notify bool) (int64, error) {
+	ctx, cancel := r.context()
+	defer cancel()
+	response, err := r.client.Get(ctx, key, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	current := make(map[string]*mvccpb.KeyValue, 0)
+	for _, kv := range response.Kvs {
+		if isBeneath(string(kv.Key), key) {
+			current[string(kv.Key)] = kv
+		}
+	}
+	if notify {
+		for _, event := range r.changes(known, current) {
+			select {
+			case <-stop:
+				return 0, etcd3StoppedErr
+			default:
+			}
+			glog.V(VERBOSE_LEVEL).Infof("Resync found the key: %s has changed, operation: %d", event.Node.Path, event.Operation)
+			r.sendEvent(event)
+		}
+	}
+	for path, _ := range known {
+		delete(known, path)
+	}
+	for path, kv := range current {
+		known[path] = kv.ModRevision
+	}
+	return response.Header.Revision, nil
+}
+
+/*
+Compares the revisions we knew for the files with those in the cluster, any new or modified
+files are CHANGED and any missing are DELETED
+*/
+func (r *Etcd3StoreClient) changes(known map[string]int64, current map[string]*mvccpb.KeyValue) []NodeChange {
+	events := make([]NodeChange, 0)
+	paths := make([]string, 0)
+	for path, _ := range current {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		kv := current[path]
+		if revision, found := known[path]; !found || revision != kv.ModRevision {
+			events = append(events, NodeChange{Node: *r.createNode(kv), Operation: CHANGED})
+		}
+	}
+	paths = make([]string, 0)
+	for path, _ := range known {
+		if _, found := current[path]; !found {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		events = append(events, NodeChange{Node: Node{Path: path}, Operation: DELETED})
+	}
+	return events
+}
+
+// Opens a watch on the key and sends the events until the watch fails or is stopped; the
+// client re-establishes the stream across a reconnect, so an error here is a cancelled watch,
+// i.e. the revision was compacted or the member lost its leader
+//	key:		the key being watched
+//	start:		the revision to watch from
+//	known:		the revisions of the files under the key
+//	stop:		the channel closed when the watch should stop
+//	seen:		called with the revision of each change seen
+func (r *Etcd3StoreClient) watchStream(key string, start int64, known map[string]int64, stop chan bool, seen func(int64)) error {
+	/* step: the watch is cancelled when stopped, which closes the channel */
+	ctx, cancel := context.WithCancel(clientv3.WithRequireLeader(context.Background()))
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for response := range r.client.Watch(ctx, key, clientv3.WithPrefix(), clientv3.WithRev(start)) {
+		if err := response.Err(); err != nil {
+			return err
+		}
+		for _, event := range response.Events {
+			path := string(event.Kv.Key)
+			seen(event.Kv.ModRevision)
+			/* step: the prefix may catch keys which only share the name, i.e. /a/key2 */
+			if !isBeneath(path, key) {
+				continue
+			}
+			change := NodeChange{Node: *r.createNode(event.Kv), Operation: CHANGED}
+			if event.Type == clientv3.EventTypeDelete {
+				change.Operation = DELETED
+				change.Node.Value = ""
+				delete(known, path)
+			} else {
+				known[path] = event.Kv.ModRevision
+			}
+			select {
+			case <-stop:
+				return nil
+			default:
+				r.sendEvent(change)
+			}
+		}
+	}
+	return errors.New("the watch was closed by etcd")
+}
+
+func (r *Etcd3StoreClient) sendEvent(event NodeChange) {
+	glog.V(VERBOSE_LEVEL).Infof("Sending notification of change on key: %s, channel: %v, event: %v", event.Node.Path, r.update_channel, event)
+	go func() {
+		r.update_channel <- event
+	}()
+}
+
+/* a context for a request against etcd, bounded by the request timeout */
+func (r *Etcd3StoreClient) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), ETCD3_REQUEST_TIMEOUT)
+}
+
+/* etcd v3 keys are stored with the leading slash, as in the v2 api */
+func (r *Etcd3StoreClient) validateKey(key string) string {
+	return "/" + strings.Trim(key, "/")
+}
+
+/* the prefix of the keys beneath a directory */
+func (r *Etcd3StoreClient) directory(path string) string {
+	if path == "/" {
+		return path
+	}
+	return path + "/"
+}
+
+func (r *Etcd3StoreClient) Get(key string) (*Node, error) {
+	lookup := r.validateKey(key)
+	glog.V(VERBOSE_LEVEL).Infof("Get() key: %s", lookup)
+	ctx, cancel := r.context()
+	defer cancel()
+	response, err := r.client.Get(ctx, lookup)
+	if err != nil {
+		glog.Errorf("Failed to get the key: %s, error: %s", lookup, err)
+		return nil, err
+	}
+	if len(response.Kvs) > 0 {
+		return r.createNode(response.Kvs[0]), nil
+	}
+	/* step: the key may be a directory, i.e. a prefix of other keys */
+	if found, err := r.isDirectory(lookup); err != nil {
+		return nil, err
+	} else if found {
+		return &Node{Path: lookup, Directory: true}, nil
+	}
+	return nil, KeyNotFoundErr
+}
+
+func (r *Etcd3StoreClient) Exists(key string) (bool, error) {
+	lookup := r.validateKey(key)
+	glog.V(VERBOSE_LEVEL).Infof("Exists() key: %s", lookup)
+	ctx, cancel := r.context()
+	defer cancel()
+	response, err := r.client.Get(ctx, lookup, clientv3.WithCountOnly())
+	if err != nil {
+		glog.Errorf("Failed to check the key: %s exists, error: %s", lookup, err)
+		return false, err
+	}
+	if response.Count > 0 {
+		return true, nil
+	}
+	return r.isDirectory(lookup)
+}
+
+/* checks if there are any keys beneath the path */
+func (r *Etcd3StoreClient) isDirectory(path string) (bool, error) {
+	ctx, cancel := r.context()
+	defer cancel()
+	response, err := r.client.Get(ctx, r.directory(path), clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return false, err
+	}
+	return response.Count > 0, nil
+}
+
+func (r *Etcd3StoreClient) Set(key string, value string) error {
+	glog.V(VERBOSE_LEVEL).Infof("Set() key: %s, value: %s", key, value)
+	lookup := r.validateKey(key)
+	ctx, cancel := r.context()
+	defer cancel()
+	/* step: a put without a lease detaches the key from any lease it had */
+	if _, err := r.client.Put(ctx, lookup, value); err != nil {
+		glog.Errorf("Failed to set the key: %s, error: %s", key, err)
+		return err
+	}
+	r.forgetLeases(lookup)
+	return nil
+}
+
+/*
+sets the key attached to a lease with the ttl, the key is deleted when the lease expires; the
+lease already attached to the key is reused rather than granting a new one on every refresh
+*/
+func (r *Etcd3StoreClient) SetTTL(key string, value string, ttl time.Duration) error {
+	glog.V(VERBOSE_LEVEL).Infof("SetTTL() key: %s, value: %s, ttl: %s", key, value, ttl)
+	lookup := r.validateKey(key)
+	ctx, cancel := r.context()
+	defer cancel()
+	lease, granted, err := r.lease(ctx, lookup, ttl)
+	if err != nil {
+		glog.Errorf("Failed to grant a lease for the key: %s, error: %s", key, err)
+		return err
+	}
+	if _, err := r.client.Put(ctx, lookup, value, clientv3.WithLease(lease)); err != nil {
+		glog.Errorf("Failed to set the key: %s, error: %s", key, err)
+		if granted {
+			r.revokeLease(lease)
+		}
+		return err
+	}
+	r.attachLease(lookup, lease)
+	return nil
+}
+
+/*
+sets the key only if its mod revision is unchanged since the index was read, or it does not exist
+if the index is zero; with a ttl the key is attached to a lease as with SetTTL
+*/
+func (r *Etcd3StoreClient) CompareAndSet(key string, value string, index uint64, ttl time.Duration) error {
+	glog.V(VERBOSE_LEVEL).Infof("CompareAndSet() key: %s, value: %s, index: %d, ttl: %s", key, value, index, ttl)
+	lookup := r.validateKey(key)
+	ctx, cancel := r.context()
+	defer cancel()
+	options := make([]clientv3.OpOption, 0)
+	var lease clientv3.LeaseID
+	var granted bool
+	if ttl > 0 {
+		var err error
+		if lease, granted, err = r.lease(ctx, lookup, ttl); err != nil {
+			glog.Errorf("Failed to grant a lease for the key: %s, error: %s", key, err)
+			return err
+		}
+		options = append(options, clientv3.WithLease(lease))
+	}
+	compare := clientv3.Compare(clientv3.ModRevision(lookup), "=", int64(index))
+	if index == 0 {
+		compare = clientv3.Compare(clientv3.CreateRevision(lookup), "=", 0)
+	}
+	response, err := r.client.Txn(ctx).If(compare).Then(clientv3.OpPut(lookup, value, options...)).Commit()
+	if err != nil || !response.Succeeded {
+		/* step: a lease granted for the put is attached to nothing */
+		if granted {
+			r.revokeLease(lease)
+		}
+		if err != nil {
+			glog.Errorf("Failed to compare and set the key: %s, error: %s", key, err)
+			return err
+		}
+		return KeyModifiedErr
+	}
+	if ttl <= 0 {
+		r.forgetLeases(lookup)
+		return nil
+	}
+	r.attachLease(lookup, lease)
+	return nil
+}
+
+/*
+retrieves a lease with the ttl for the key; the lease attached to the key is kept alive and
+reused if it was granted with the same ttl, else a new lease is granted. Returns true if the
+lease was granted, i.e. it must be revoked if not attached to the key
+*/
+func (r *Etcd3StoreClient) lease(ctx context.Context, lookup string, ttl time.Duration) (clientv3.LeaseID, bool, error) {
+	r.RLock()
+	id, found := r.leases[lookup]
+	r.RUnlock()
+	if found {
+		/* step: an expired lease or a failure falls back to a new lease */
+		response, err := r.client.KeepAliveOnce(ctx, id)
+		if err == nil && response.TTL == ttlSeconds(ttl) {
+			return id, false, nil
+		}
+	}
+	response, err := r.client.Grant(ctx, ttlSeconds(ttl))
+	if err != nil {
+		return 0, false, err
+	}
+	return response.ID, true, nil
+}
+
+/* records the lease attached to the key, revoking the lease it replaces */
+func (r *Etcd3StoreClient) attachLease(lookup string, id clientv3.LeaseID) {
+	r.Lock()
+	former, found := r.leases[lookup]
+	r.leases[lookup] = id
+	r.Unlock()
+	if found && former != id {
+		r.revokeLease(former)
+	}
+}
+
+/* revokes a lease no longer attached to the key; on a failure the lease is left to expire */
+func (r *Etcd3StoreClient) revokeLease(id clientv3.LeaseID) {
+	ctx, cancel := r.context()
+	defer cancel()
+	if _, err := r.client.Revoke(ctx, id); err != nil && err != rpctypes.ErrLeaseNotFound {
+		glog.Errorf("Failed to revoke the lease: %d, error: %s", id, err)
+	}
+}
+
+/* keeps the lease attached to the key alive, the ttl is that given when the lease was granted */
+func (r *Etcd3StoreClient) Refresh(key string, ttl time.Duration) error {
+	glog.V(VERBOSE_LEVEL).Infof("Refresh() key: %s, ttl: %s", key, ttl)
+	lookup := r.validateKey(key)
+	r.RLock()
+	id, found := r.leases[lookup]
+	r.RUnlock()
+	if !found {
+		return KeyNotFoundErr
+	}
+	ctx, cancel := r.context()
+	defer cancel()
+	_, err := r.client.KeepAliveOnce(ctx, id)
+	/* step: an expired lease is not found, the client also returns this when no ttl is left */
+	if err == rpctypes.ErrLeaseNotFound {
+		r.forgetLeases(lookup)
+		return KeyNotFoundErr
+	}
+	if err != nil {
+		glog.Errorf("Failed to refresh the key: %s, error: %s", key, err)
+		return err
+	}
+	return nil
+}
+
+/*
+forgets and revokes the leases of the key and anything beneath it; the keys have been deleted
+or set without a lease, so nothing is attached to them any longer
+*/
+func (r *Etcd3StoreClient) forgetLeases(path string) {
+	r.Lock()
+	forgotten := make([]clientv3.LeaseID, 0)
+	for key, id := range r.leases {
+		if isBeneath(key, path) {
+			forgotten = append(forgotten, id)
+			delete(r.leases, key)
+		}
+	}
+	r.Unlock()
+	for _, id := range forgotten {
+		r.revokeLease(id)
+	}
+}
+
+func (r *Etcd3StoreClient) Delete(key string) error {
+	glog.V(VERBOSE_LEVEL).Infof("Delete() deleting the key: %s", key)
+	ctx, cancel := r.context()
+	defer cancel()
+	if _, err := r.client.Delete(ctx, r.validateKey(key)); err != nil {
+		glog.Errorf("Delete() failed to delete key: %s, error: %s", key, err)
+		return err
+	}
+	r.forgetLeases(r.validateKey(key))
+	return nil
+}
+
+func (r *Etcd3StoreClient) RemovePath(path string) error {
+	glog.V(VERBOSE_LEVEL).Infof("RemovePath() deleting the path: %s", path)
+	lookup := r.validateKey(path)
+	ctx, cancel := r.context()
+	defer cancel()
+	/* step: remove everything beneath the path and the key itself in the one transaction */
+	_, err := r.client.Txn(ctx).Then(
+		clientv3.OpDelete(r.directory(lookup), clientv3.WithPrefix()),
+		clientv3.OpDelete(lookup)).Commit()
+	if err != nil {
+		glog.Errorf("RemovePath() failed to delete key: %s, error: %s", path, err)
+		return err
+	}
+	r.forgetLeases(lookup)
+	return nil
+}
+
+func (r *Etcd3StoreClient) List(path string) ([]*Node, error) {
+	key := r.validateKey(path)
+	glog.V(VERBOSE_LEVEL).Infof("List() path: %s", key)
+	prefix := r.directory(key)
+	ctx, cancel := r.context()
+	defer cancel()
+	response, err := r.client.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		glog.Errorf("List() failed to get path: %s, error: %s", key, err)
+		return nil, err
+	}
+	if len(response.Kvs) <= 0 {
+		glog.Errorf("List() path: %s is not a directory node", key)
+		return nil, InvalidDirectoryErr
+	}
+	return r.children(prefix, response.Kvs), nil
+}
+
+// Generates the immediate children of a path from a prefix listing, emulating directories
+//	prefix:		the prefix of the keys beneath the path
+//	entries:	all the keys under the path
+func (r *Etcd3StoreClient) children(prefix string, entries []*mvccpb.KeyValue) []*Node {
+	list := make([]*Node, 0)
+	directories := make(map[string]bool, 0)
+	for _, entry := range entries {
+		name := strings.TrimPrefix(string(entry.Key), prefix)
+		if name == "" {
+			continue
+		}
+		if index := strings.Index(name, "/"); index >= 0 {
+			directory := prefix + name[:index]
+			if !directories[directory] {
+				directories[directory] = true
+				list = append(list, &Node{Path: directory, Directory: true})
+			}
+			continue
+		}
+		list = append(list, r.createNode(entry))
+	}
+	return list
+}
+
+func (r *Etcd3StoreClient) Paths(path string, paths *[]string) ([]string, error) {
+	prefix := r.directory(r.validateKey(path))
+	ctx, cancel := r.context()
+	defer cancel()
+	response, err := r.client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return nil, errors.New("Unable to complete walking the tree" + err.Error())
+	}
+	for _, kv := range response.Kvs {
+		*paths = append(*paths, string(kv.Key))
+	}
+	return *paths, nil
+}
+
+func (r *Etcd3StoreClient) createNode(entry *mvccpb.KeyValue) *Node {
+	return &Node{Path: string(entry.Key), Value: string(entry.Value), Index: uint64(entry.ModRevision)}
+}
+
+/* checks the path is the key or beneath it */
+func isBeneath(path, key string) bool {
+	return path == key || key == "/" || strings.HasPrefix(path, key+"/")
+}
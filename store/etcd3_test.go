@@ -0,0 +1,438 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+)
+
+/*
+The tests run against a real etcd v3 member, tests/setup.sh starts one; the endpoint can be
+changed with ETCD3_ENDPOINT and the tests are skipped if it cannot be reached
+*/
+const ETCD3_ENDPOINT = "127.0.0.1:2379"
+
+/* a tcp proxy in front of etcd, used to drop the connections of the client under test */
+type etcd3Proxy struct {
+	sync.Mutex
+	listener    net.Listener
+	target      string
+	offline     bool
+	connections []net.Conn
+}
+
+func newEtcd3Proxy(t *testing.T, target string) *etcd3Proxy {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to create the proxy listener, error: %s", err)
+	}
+	proxy := &etcd3Proxy{listener: listener, target: target}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			proxy.forward(conn)
+		}
+	}()
+	return proxy
+}
+
+func (r *etcd3Proxy) forward(conn net.Conn) {
+	r.Lock()
+	defer r.Unlock()
+	if r.offline {
+		conn.Close()
+		return
+	}
+	upstream, err := net.Dial("tcp", r.target)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	r.connections = append(r.connections, conn, upstream)
+	for _, pipe := range [][]net.Conn{{conn, upstream}, {upstream, conn}} {
+		go func(from, to net.Conn) {
+			io.Copy(to, from)
+			from.Close()
+			to.Close()
+		}(pipe[0], pipe[1])
+	}
+}
+
+/* drops the connections and refuses any new ones until reconnected */
+func (r *etcd3Proxy) disconnect() {
+	r.Lock()
+	defer r.Unlock()
+	r.offline = true
+	for _, conn := range r.connections {
+		conn.Close()
+	}
+	r.connections = nil
+}
+
+func (r *etcd3Proxy) reconnect() {
+	r.Lock()
+	defer r.Unlock()
+	r.offline = false
+}
+
+func (r *etcd3Proxy) Close() {
+	r.listener.Close()
+	r.disconnect()
+}
+
+/* the client under test, connected through the proxy, and a client connected directly */
+type testEtcd3 struct {
+	client  *Etcd3StoreClient
+	updates NodeUpdateChannel
+	proxy   *etcd3Proxy
+	direct  *clientv3.Client
+	/* the prefix of the keys used by the test */
+	base string
+}
+
+func newTestEtcd3Client(t *testing.T) *testEtcd3 {
+	endpoint := os.Getenv("ETCD3_ENDPOINT")
+	if endpoint == "" {
+		endpoint = ETCD3_ENDPOINT
+	}
+	conn, err := net.DialTimeout("tcp", endpoint, time.Second)
+	if err != nil {
+		t.Skipf("etcd v3 is not available on: %s, error: %s", endpoint, err)
+	}
+	conn.Close()
+	direct, err := clientv3.New(clientv3.Config{Endpoints: []string{endpoint}, DialTimeout: 2 * time.Second})
+	if err != nil {
+		t.Skipf("etcd v3 is not available on: %s, error: %s", endpoint, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if _, err := direct.Get(ctx, "/"); err != nil {
+		direct.Close()
+		t.Skipf("etcd v3 is not available on: %s, error: %s", endpoint, err)
+	}
+	proxy := newEtcd3Proxy(t, endpoint)
+	channel := make(NodeUpdateChannel, 10)
+	client, err := newEtcd3StoreClient(clientv3.Config{
+		Endpoints:   []string{proxy.listener.Addr().String()},
+		DialTimeout: 2 * time.Second,
+		/* step: reconnect quickly once the proxy is back */
+		DialOptions: []grpc.DialOption{grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           backoff.Config{BaseDelay: 50 * time.Millisecond, Multiplier: 1.6, MaxDelay: 500 * time.Millisecond},
+			MinConnectTimeout: time.Second,
+		})},
+	}, channel)
+	assert.Nil(t, err)
+	client.retry_wait = 100 * time.Millisecond
+	return &testEtcd3{
+		client:  client,
+		updates: channel,
+		proxy:   proxy,
+		direct:  direct,
+		base:    fmt.Sprintf("/config-hook-test/%d", time.Now().UnixNano()),
+	}
+}
+
+/* the key beneath the prefix of the test */
+func (r *testEtcd3) key(name string) string {
+	return r.base + name
+}
+
+func (r *testEtcd3) Close() {
+	r.client.Close()
+	r.proxy.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	r.direct.Delete(ctx, r.base+"/", clientv3.WithPrefix())
+	r.direct.Close()
+}
+
+/* the ids of the leases granted in etcd */
+func (r *testEtcd3) leases(t *testing.T) map[clientv3.LeaseID]bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	response, err := r.direct.Leases(ctx)
+	assert.Nil(t, err)
+	leases := make(map[clientv3.LeaseID]bool, 0)
+	if response != nil {
+		for _, lease := range response.Leases {
+			leases[lease.ID] = true
+		}
+	}
+	return leases
+}
+
+/* checks if the lease is still granted in etcd */
+func (r *testEtcd3) hasLease(t *testing.T, id clientv3.LeaseID) bool {
+	return r.leases(t)[id]
+}
+
+func expectEtcd3Event(t *testing.T, updates NodeUpdateChannel, operation int, path, value string) {
+	select {
+	case event := <-updates:
+		assert.Equal(t, operation, int(event.Operation))
+		assert.Equal(t, path, event.Node.Path)
+		assert.Equal(t, value, event.Node.Value)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "we timed out waiting for an event")
+	}
+}
+
+func TestEtcd3SetGet(t *testing.T) {
+	etcd3 := newTestEtcd3Client(t)
+	defer etcd3.Close()
+	client := etcd3.client
+	assert.Nil(t, client.Set(etcd3.key("/test/key"), "value"))
+	node, err := client.Get(etcd3.key("/test/key"))
+	assert.Nil(t, err)
+	assert.Equal(t, etcd3.key("/test/key"), node.Path)
+	assert.Equal(t, "value", node.Value)
+	assert.True(t, node.IsFile())
+	node, err = client.Get(etcd3.key("/test"))
+	assert.Nil(t, err)
+	assert.True(t, node.IsDir())
+	_, err = client.Get(etcd3.key("/test/missing"))
+	assert.Equal(t, KeyNotFoundErr, err)
+}
+
+func TestEtcd3ExistsDelete(t *testing.T) {
+	etcd3 := newTestEtcd3Client(t)
+	defer etcd3.Close()
+	client := etcd3.client
+	assert.Nil(t, client.Set(etcd3.key("/test/key"), "value"))
+	found, err := client.Exists(etcd3.key("/test/key"))
+	assert.Nil(t, err)
+	assert.True(t, found)
+	found, err = client.Exists(etcd3.key("/test"))
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Nil(t, client.Delete(etcd3.key("/test/key")))
+	found, err = client.Exists(etcd3.key("/test/key"))
+	assert.Nil(t, err)
+	assert.False(t, found)
+	found, err = client.Exists(etcd3.key("/test"))
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
+
+func TestEtcd3ListPaths(t *testing.T) {
+	etcd3 := newTestEtcd3Client(t)
+	defer etcd3.Close()
+	client := etcd3.client
+	assert.Nil(t, client.Set(etcd3.key("/test/one"), "1"))
+	assert.Nil(t, client.Set(etcd3.key("/test/two"), "2"))
+	assert.Nil(t, client.Set(etcd3.key("/test/dir/three"), "3"))
+	assert.Nil(t, client.Set(etcd3.key("/test2/other"), "4"))
+	list, err := client.List(etcd3.key("/test"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(list))
+	directories := 0
+	for _, node := range list {
+		if node.IsDir() {
+			directories++
+			assert.Equal(t, etcd3.key("/test/dir"), node.Path)
+		}
+	}
+	assert.Equal(t, 1, directories)
+
+	paths := make([]string, 0)
+	_, err = client.Paths(etcd3.key("/test"), &paths)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{etcd3.key("/test/dir/three"), etcd3.key("/test/one"), etcd3.key("/test/two")}, paths)
+
+	assert.Nil(t, client.RemovePath(etcd3.key("/test")))
+	_, err = client.List(etcd3.key("/test"))
+	assert.Equal(t, InvalidDirectoryErr, err)
+	found, err := client.Exists(etcd3.key("/test2/other"))
+	assert.Nil(t, err)
+	assert.True(t, found)
+}
+
+func TestEtcd3Watch(t *testing.T) {
+	etcd3 := newTestEtcd3Client(t)
+	defer etcd3.Close()
+	client, updates := etcd3.client, etcd3.updates
+	assert.Nil(t, client.Set(etcd3.key("/test/watch"), "1"))
+	client.Watch(etcd3.key("/test/watch"))
+	// step: give the watcher a chance to take the baseline
+	time.Sleep(200 * time.Millisecond)
+	assert.Nil(t, client.Set(etcd3.key("/test/watch2"), "ignored"))
+	assert.Nil(t, client.Set(etcd3.key("/test/watch"), "2"))
+	expectEtcd3Event(t, updates, CHANGED, etcd3.key("/test/watch"), "2")
+	assert.Nil(t, client.Delete(etcd3.key("/test/watch")))
+	expectEtcd3Event(t, updates, DELETED, etcd3.key("/test/watch"), "")
+	client.Unwatch(etcd3.key("/test/watch"))
+	assert.Nil(t, client.Set(etcd3.key("/test/watch"), "3"))
+	select {
+	case <-updates:
+		assert.Fail(t, "we should not have recieved an event here")
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func TestEtcd3WatchReconnect(t *testing.T) {
+	etcd3 := newTestEtcd3Client(t)
+	defer etcd3.Close()
+	client, updates := etcd3.client, etcd3.updates
+	assert.Nil(t, client.Set(etcd3.key("/test/dir/one"), "1"))
+	client.Watch(etcd3.key("/test/dir"))
+	time.Sleep(200 * time.Millisecond)
+
+	// step: changes made while disconnected are replayed from the revision
+	etcd3.proxy.disconnect()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err := etcd3.direct.Put(ctx, etcd3.key("/test/dir/two"), "2")
+	assert.Nil(t, err)
+	etcd3.proxy.reconnect()
+	expectEtcd3Event(t, updates, CHANGED, etcd3.key("/test/dir/two"), "2")
+}
+
+func TestEtcd3WatchCompacted(t *testing.T) {
+	etcd3 := newTestEtcd3Client(t)
+	defer etcd3.Close()
+	client, updates := etcd3.client, etcd3.updates
+	assert.Nil(t, client.Set(etcd3.key("/test/dir/one"), "1"))
+	assert.Nil(t, client.Set(etcd3.key("/test/dir/two"), "2"))
+	client.Watch(etcd3.key("/test/dir"))
+	time.Sleep(200 * time.Millisecond)
+
+	// step: the history we missed is compacted, so the watch must resync
+	etcd3.proxy.disconnect()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	changed, err := etcd3.direct.Put(ctx, etcd3.key("/test/dir/one"), "changed")
+	assert.Nil(t, err)
+	_, err = etcd3.direct.Delete(ctx, etcd3.key("/test/dir/two"))
+	assert.Nil(t, err)
+	response, err := etcd3.direct.Put(ctx, etcd3.key("/test/dir/three"), "3")
+	assert.Nil(t, err)
+	_, err = etcd3.direct.Compact(ctx, response.Header.Revision)
+	assert.Nil(t, err)
+	etcd3.proxy.reconnect()
+	// step: the events are sent concurrently, so the order is not guaranteed
+	events := make(map[string]NodeChange, 0)
+	for i := 0; i < 3; i++ {
+		select {
+		case event := <-updates:
+			events[event.Node.Path] = event
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "we timed out waiting for an event")
+		}
+	}
+	assert.Equal(t, NodeChange{Node: Node{Path: etcd3.key("/test/dir/one"), Value: "changed", Index: uint64(changed.Header.Revision)}, Operation: CHANGED}, events[etcd3.key("/test/dir/one")])
+	assert.Equal(t, NodeChange{Node: Node{Path: etcd3.key("/test/dir/three"), Value: "3", Index: uint64(response.Header.Revision)}, Operation: CHANGED}, events[etcd3.key("/test/dir/three")])
+	assert.Equal(t, NodeChange{Node: Node{Path: etcd3.key("/test/dir/two")}, Operation: DELETED}, events[etcd3.key("/test/dir/two")])
+}
+
+func TestEtcd3TTL(t *testing.T) {
+	etcd3 := newTestEtcd3Client(t)
+	defer etcd3.Close()
+	client := etcd3.client
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Nil(t, client.SetTTL(etcd3.key("/test/ttl"), "1", time.Minute))
+	assert.Nil(t, client.Refresh(etcd3.key("/test/ttl"), time.Minute))
+
+	// step: when the lease expires the key is deleted and the refresh fails
+	_, err := etcd3.direct.Revoke(ctx, client.leases[etcd3.key("/test/ttl")])
+	assert.Nil(t, err)
+	found, err := client.Exists(etcd3.key("/test/ttl"))
+	assert.Nil(t, err)
+	assert.False(t, found)
+	assert.Equal(t, KeyNotFoundErr, client.Refresh(etcd3.key("/test/ttl"), time.Minute))
+
+	// step: a key set without a ttl is detached from the lease, which is revoked
+	assert.Nil(t, client.SetTTL(etcd3.key("/test/ttl"), "2", time.Minute))
+	lease := client.leases[etcd3.key("/test/ttl")]
+	assert.Nil(t, client.Set(etcd3.key("/test/ttl"), "3"))
+	assert.Equal(t, KeyNotFoundErr, client.Refresh(etcd3.key("/test/ttl"), time.Minute))
+	assert.False(t, etcd3.hasLease(t, lease))
+	node, err := client.Get(etcd3.key("/test/ttl"))
+	assert.Nil(t, err)
+	assert.Equal(t, "3", node.Value)
+}
+
+func TestEtcd3TTLLease(t *testing.T) {
+	etcd3 := newTestEtcd3Client(t)
+	defer etcd3.Close()
+	client := etcd3.client
+	key := etcd3.key("/test/lease")
+	// step: setting the key again reuses the lease attached to it
+	assert.Nil(t, client.SetTTL(key, "1", time.Minute))
+	lease := client.leases[key]
+	assert.Nil(t, client.SetTTL(key, "2", time.Minute))
+	assert.Equal(t, lease, client.leases[key])
+	node, err := client.Get(key)
+	assert.Nil(t, err)
+	assert.Nil(t, client.CompareAndSet(key, "3", node.Index, time.Minute))
+	assert.Equal(t, lease, client.leases[key])
+
+	// step: a different ttl needs a new lease, the former is revoked
+	assert.Nil(t, client.SetTTL(key, "4", 2*time.Minute))
+	assert.NotEqual(t, lease, client.leases[key])
+	assert.False(t, etcd3.hasLease(t, lease))
+	assert.True(t, etcd3.hasLease(t, client.leases[key]))
+
+	// step: a failed compare revokes the lease granted for it
+	before := etcd3.leases(t)
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet(etcd3.key("/test/other"), "1", 1, time.Minute))
+	assert.Equal(t, before, etcd3.leases(t))
+
+	// step: the lease is revoked with the key
+	lease = client.leases[key]
+	assert.Nil(t, client.Delete(key))
+	assert.False(t, etcd3.hasLease(t, lease))
+}
+
+func TestEtcd3CompareAndSet(t *testing.T) {
+	etcd3 := newTestEtcd3Client(t)
+	defer etcd3.Close()
+	client := etcd3.client
+	// step: an index of zero only creates the key
+	assert.Nil(t, client.CompareAndSet(etcd3.key("/test/cas"), "1", 0, 0))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet(etcd3.key("/test/cas"), "2", 0, 0))
+	node, err := client.Get(etcd3.key("/test/cas"))
+	assert.Nil(t, err)
+	assert.Equal(t, "1", node.Value)
+	assert.True(t, node.Index > 0)
+	// step: the index must match the mod revision of the key
+	assert.Nil(t, client.CompareAndSet(etcd3.key("/test/cas"), "2", node.Index, 0))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet(etcd3.key("/test/cas"), "3", node.Index, 0))
+	node, err = client.Get(etcd3.key("/test/cas"))
+	assert.Nil(t, err)
+	assert.Equal(t, "2", node.Value)
+
+	// step: with a ttl the key is attached to a lease which can be refreshed
+	assert.Nil(t, client.CompareAndSet(etcd3.key("/test/cas"), "4", node.Index, time.Minute))
+	assert.Nil(t, client.Refresh(etcd3.key("/test/cas"), time.Minute))
+	node, err = client.Get(etcd3.key("/test/cas"))
+	assert.Nil(t, err)
+	assert.Nil(t, client.CompareAndSet(etcd3.key("/test/cas"), "5", node.Index, 0))
+	assert.Equal(t, KeyNotFoundErr, client.Refresh(etcd3.key("/test/cas"), time.Minute))
+}
@@ -14,9 +14,16 @@ limitations under the License.
 package store
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
+	etcd "github.com/coreos/go-etcd/etcd"
 	"github.com/stretchr/testify/assert"
 	"time"
 )
@@ -60,6 +67,18 @@ func TestDelete(t *testing.T) {
 	assert.Nil(t, client.Delete(ETCD_KEY))
 }
 
+func TestExists(t *testing.T) {
+	err := client.Set(ETCD_KEY, ETCD_VAL)
+	assert.Nil(t, err)
+	found, err := client.Exists(ETCD_KEY)
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Nil(t, client.Delete(ETCD_KEY))
+	found, err = client.Exists(ETCD_KEY)
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
+
 func TestWatch(t *testing.T) {
 	err := client.Set(ETCD_KEY, ETCD_VAL)
 	assert.Nil(t, err)
@@ -119,3 +138,114 @@ func TestList(t *testing.T) {
 	assert.Equal(t, 3, len(list))
 }
 
+func TestCompareAndSet(t *testing.T) {
+	client.Delete("/test/cas")
+	assert.Nil(t, client.CompareAndSet("/test/cas", "1", 0, 0))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet("/test/cas", "2", 0, 0))
+	node, err := client.Get("/test/cas")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", node.Value)
+	assert.Nil(t, client.CompareAndSet("/test/cas", "2", node.Index, 0))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet("/test/cas", "3", node.Index, 0))
+	assert.Nil(t, client.Delete("/test/cas"))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet("/test/cas", "4", node.Index, 0))
+}
+
+
+func TestEtcdDiffNodes(t *testing.T) {
+	root := &etcd.Node{Key: "/env", Dir: true, Nodes: etcd.Nodes{
+		&etcd.Node{Key: "/env/one", Value: "1", ModifiedIndex: 10},
+		&etcd.Node{Key: "/env/two", Value: "2", ModifiedIndex: 12},
+		&etcd.Node{Key: "/env/sub", Dir: true, Nodes: etcd.Nodes{
+			&etcd.Node{Key: "/env/sub/three", Value: "3", ModifiedIndex: 14},
+		}},
+	}}
+	current := make(map[string]*etcd.Node, 0)
+	flattenNodes(root, current)
+	assert.Equal(t, 3, len(current))
+
+	known := map[string]uint64{
+		"/env/one":  10,
+		"/env/two":  11,
+		"/env/gone": 9,
+	}
+	events := diffNodes(known, current)
+	assert.Equal(t, []NodeChange{
+		{Node: Node{Path: "/env/sub/three", Value: "3"}, Operation: CHANGED},
+		{Node: Node{Path: "/env/two", Value: "2"}, Operation: CHANGED},
+		{Node: Node{Path: "/env/gone"}, Operation: DELETED},
+	}, events)
+	assert.Equal(t, 0, len(diffNodes(map[string]uint64{"/env/one": 10, "/env/two": 12, "/env/sub/three": 14}, current)))
+}
+
+func TestEtcdResyncWriteBetweenReads(t *testing.T) {
+	var lock sync.Mutex
+	index := uint64(10)
+	values := map[string]*etcd.Node{
+		"/env/a": &etcd.Node{Key: "/env/a", Value: "1", ModifiedIndex: 5},
+		"/env/b": &etcd.Node{Key: "/env/b", Value: "1", ModifiedIndex: 6},
+	}
+	watches := make(chan uint64, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		key := strings.TrimPrefix(request.URL.Path, "/v2/keys")
+		response := &etcd.Response{Action: "get"}
+		switch {
+		case request.URL.Query().Get("wait") == "true":
+			wait_index, _ := strconv.ParseUint(request.URL.Query().Get("waitIndex"), 10, 64)
+			watches <- wait_index
+			if wait_index > index {
+				// step: nothing to replay, hold the watch until the client goes away
+				lock.Unlock()
+				<-request.Context().Done()
+				lock.Lock()
+				return
+			}
+			response = &etcd.Response{Action: "set", Node: values["/env/a"]}
+		case key == "/":
+			response.Node = &etcd.Node{Key: "/", Dir: true}
+		default:
+			response.Node = values[key]
+			// step: the write to a lands after a has been read and before b is
+			if key == "/env/a" {
+				index++
+				values["/env/a"] = &etcd.Node{Key: "/env/a", Value: "2", ModifiedIndex: index}
+			}
+		}
+		writer.Header().Set("X-Etcd-Index", strconv.FormatUint(index, 10))
+		json.NewEncoder(writer).Encode(response)
+	}))
+	defer server.Close()
+
+	channel := make(NodeUpdateChannel, 10)
+	store := &EtcdStoreClient{
+		base_key:       "/",
+		client:         etcd.NewClient([]string{server.URL}),
+		stop_channel:   make(chan bool),
+		watchedKeys:    map[string]bool{"/env/a": true, "/env/b": true},
+		known:          map[string]uint64{"/env/a": 5, "/env/b": 6},
+		update_channel: channel,
+	}
+	store.processEvents()
+	defer store.Close()
+
+	// step: the watch must resume from before the first read, replaying the write to a
+	select {
+	case wait_index := <-watches:
+		assert.Equal(t, uint64(11), wait_index)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the watch was not started")
+	}
+	for {
+		select {
+		case event := <-channel:
+			if event.Node.Path == "/env/a" {
+				assert.Equal(t, "2", event.Node.Value)
+				return
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("the change to /env/a was not sent")
+		}
+	}
+}
@@ -0,0 +1,383 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"errors"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+type MemoryStoreClient struct {
+	/* a lock for the tree and the watcher map */
+	sync.RWMutex
+	/* the root of the tree */
+	root *memoryNode
+	/* a map of keys presently being watched */
+	watchedKeys map[string]bool
+	/* the index of the last change, incremented on every set */
+	index uint64
+	/* the channel used to send node updates */
+	update_channel chan NodeChange
+}
+
+/* a node in the in memory tree */
+type memoryNode struct {
+	/* the value of the node if a file */
+	value string
+	/* the children of the node if a directory */
+	children map[string]*memoryNode
+	/* the timer which expires the node, if it has a ttl */
+	expiry *time.Timer
+	/* the index of the change which set the node */
+	index uint64
+}
+
+const (
+	MEMORY_PREFIX = "memory://"
+)
+
+var NotFileErr = errors.New("The key is a directory, not a file")
+
+func NewMemoryStoreClient(location *url.URL, channel NodeUpdateChannel) (Store, error) {
+	glog.Infof("Creating a in memory Agent for K/V Store")
+	store := new(MemoryStoreClient)
+	store.root = newMemoryDirectory()
+	store.watchedKeys = make(map[string]bool, 0)
+	store.update_channel = channel
+	return store, nil
+}
+
+func newMemoryDirectory() *memoryNode {
+	return &memoryNode{children: make(map[string]*memoryNode, 0)}
+}
+
+func (r *memoryNode) isDir() bool {
+	return r.children != nil
+}
+
+func (r *MemoryStoreClient) Close() {
+	glog.Infof("Shutting down the memory client")
+}
+
+func (r *MemoryStoreClient) Watch(key string) {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	// step: we check if the key is being watched and if not add it
+	if _, found := r.watchedKeys[key]; found {
+		glog.V(VERBOSE_LEVEL).Infof("Thy key: %s is already being wathed, skipping for now", key)
+	} else {
+		glog.V(VERBOSE_LEVEL).Infof("Adding a watch on the key: %s", key)
+		r.watchedKeys[key] = true
+	}
+}
+
+func (r *MemoryStoreClient) Unwatch(key string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.watchedKeys, r.validateKey(key))
+}
+
+func (r *MemoryStoreClient) validateKey(key string) string {
+	return "/" + strings.Trim(key, "/")
+}
+
+/* splits the key into the elements of the path */
+func (r *MemoryStoreClient) elements(key string) []string {
+	path := strings.Trim(key, "/")
+	if path == "" {
+		return []string{}
+	}
+	return strings.Split(path, "/")
+}
+
+/* walks the tree to the node for the key, must be called with the lock held */
+func (r *MemoryStoreClient) lookup(key string) (*memoryNode, error) {
+	node := r.root
+	for _, element := range r.elements(key) {
+		if !node.isDir() {
+			return nil, KeyNotFoundErr
+		}
+		child, found := node.children[element]
+		if !found {
+			return nil, KeyNotFoundErr
+		}
+		node = child
+	}
+	return node, nil
+}
+
+func (r *MemoryStoreClient) Get(key string) (*Node, error) {
+	r.RLock()
+	defer r.RUnlock()
+	key = r.validateKey(key)
+	glog.V(VERBOSE_LEVEL).Infof("Get() key: %s", key)
+	node, err := r.lookup(key)
+	if err != nil {
+		return nil, err
+	}
+	return r.createNode(key, node), nil
+}
+
+func (r *MemoryStoreClient) Exists(key string) (bool, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if _, err := r.lookup(r.validateKey(key)); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+func (r *MemoryStoreClient) Set(key string, value string) error {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	glog.V(VERBOSE_LEVEL).Infof("Set() key: %s, value: %s", key, value)
+	_, err := r.set(key, value)
+	return err
+}
+
+func (r *MemoryStoreClient) SetTTL(key string, value string, ttl time.Duration) error {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	glog.V(VERBOSE_LEVEL).Infof("SetTTL() key: %s, value: %s, ttl: %s", key, value, ttl)
+	node, err := r.set(key, value)
+	if err != nil {
+		return err
+	}
+	r.expire(key, node, ttl)
+	return nil
+}
+
+func (r *MemoryStoreClient) CompareAndSet(key string, value string, index uint64, ttl time.Duration) error {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	glog.V(VERBOSE_LEVEL).Infof("CompareAndSet() key: %s, value: %s, index: %d, ttl: %s", key, value, index, ttl)
+	current, err := r.lookup(key)
+	if err != nil {
+		current = nil
+	}
+	if (current == nil && index != 0) || (current != nil && current.index != index) {
+		return KeyModifiedErr
+	}
+	node, err := r.set(key, value)
+	if err != nil {
+		return err
+	}
+	if ttl > 0 {
+		r.expire(key, node, ttl)
+	}
+	return nil
+}
+
+func (r *MemoryStoreClient) Refresh(key string, ttl time.Duration) error {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	glog.V(VERBOSE_LEVEL).Infof("Refresh() key: %s, ttl: %s", key, ttl)
+	node, err := r.lookup(key)
+	if err != nil {
+		return err
+	}
+	if node.isDir() {
+		return NotFileErr
+	}
+	if node.expiry == nil || !node.expiry.Stop() {
+		return KeyNotFoundErr
+	}
+	r.expire(key, node, ttl)
+	return nil
+}
+
+/* removes the node from the tree once the ttl has passed, unless it has been replaced since */
+func (r *MemoryStoreClient) expire(key string, node *memoryNode, ttl time.Duration) {
+	node.expiry = time.AfterFunc(ttl, func() {
+		r.Lock()
+		defer r.Unlock()
+		if current, err := r.lookup(key); err == nil && current == node {
+			glog.V(VERBOSE_LEVEL).Infof("The ttl on key: %s has expired", key)
+			r.remove(key)
+		}
+	})
+}
+
+/* sets the value of the file, creating any directories as required, must be called with the lock held */
+func (r *MemoryStoreClient) set(key string, value string) (*memoryNode, error) {
+	elements := r.elements(key)
+	if len(elements) <= 0 {
+		return nil, NotFileErr
+	}
+	// step: walk the tree creating any directories as required
+	node := r.root
+	for _, element := range elements[:len(elements)-1] {
+		child, found := node.children[element]
+		if !found {
+			child = newMemoryDirectory()
+			node.children[element] = child
+		}
+		if !child.isDir() {
+			return nil, InvalidDirectoryErr
+		}
+		node = child
+	}
+	name := elements[len(elements)-1]
+	if child, found := node.children[name]; found {
+		if child.isDir() {
+			return nil, NotFileErr
+		}
+		// step: the ttl goes with the value being replaced
+		if child.expiry != nil {
+			child.expiry.Stop()
+		}
+	}
+	r.index++
+	file := &memoryNode{value: value, index: r.index}
+	node.children[name] = file
+	r.notify(key, value, CHANGED)
+	return file, nil
+}
+
+func (r *MemoryStoreClient) Delete(key string) error {
+	r.Lock()
+	defer r.Unlock()
+	key = r.validateKey(key)
+	glog.V(VERBOSE_LEVEL).Infof("Delete() deleting the key: %s", key)
+	node, err := r.lookup(key)
+	if err != nil {
+		return err
+	}
+	if node.isDir() {
+		return NotFileErr
+	}
+	return r.remove(key)
+}
+
+func (r *MemoryStoreClient) RemovePath(path string) error {
+	r.Lock()
+	defer r.Unlock()
+	path = r.validateKey(path)
+	glog.V(VERBOSE_LEVEL).Infof("RemovePath() deleting the path: %s", path)
+	if _, err := r.lookup(path); err != nil {
+		return err
+	}
+	return r.remove(path)
+}
+
+/* removes the node from the tree and notifies of any files deleted, must be called with the lock held */
+func (r *MemoryStoreClient) remove(key string) error {
+	elements := r.elements(key)
+	if len(elements) <= 0 {
+		return InvalidDirectoryErr
+	}
+	parent, err := r.lookup(strings.Join(elements[:len(elements)-1], "/"))
+	if err != nil {
+		return err
+	}
+	name := elements[len(elements)-1]
+	node := parent.children[name]
+	delete(parent.children, name)
+	// step: notify of all the files which have gone
+	files := make([]string, 0)
+	r.walk(key, node, &files)
+	for _, file := range files {
+		r.notify(file, "", DELETED)
+	}
+	return nil
+}
+
+/* collects all the file paths under the node */
+func (r *MemoryStoreClient) walk(path string, node *memoryNode, paths *[]string) {
+	if !node.isDir() {
+		*paths = append(*paths, path)
+		return
+	}
+	for _, name := range r.sortedChildren(node) {
+		r.walk(strings.TrimSuffix(path, "/")+"/"+name, node.children[name], paths)
+	}
+}
+
+func (r *MemoryStoreClient) sortedChildren(node *memoryNode) []string {
+	names := make([]string, 0)
+	for name, _ := range node.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *MemoryStoreClient) List(path string) ([]*Node, error) {
+	r.RLock()
+	defer r.RUnlock()
+	path = r.validateKey(path)
+	glog.V(VERBOSE_LEVEL).Infof("List() path: %s", path)
+	node, err := r.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+	if !node.isDir() {
+		glog.Errorf("List() path: %s is not a directory node", path)
+		return nil, InvalidDirectoryErr
+	}
+	list := make([]*Node, 0)
+	for _, name := range r.sortedChildren(node) {
+		list = append(list, r.createNode(strings.TrimSuffix(path, "/")+"/"+name, node.children[name]))
+	}
+	return list, nil
+}
+
+func (r *MemoryStoreClient) Paths(path string, paths *[]string) ([]string, error) {
+	r.RLock()
+	defer r.RUnlock()
+	path = r.validateKey(path)
+	node, err := r.lookup(path)
+	if err != nil {
+		return nil, errors.New("Unable to complete walking the tree" + err.Error())
+	}
+	r.walk(path, node, paths)
+	return *paths, nil
+}
+
+/* sends a change event upstream if the key is being watched, must be called with the lock held */
+func (r *MemoryStoreClient) notify(key, value string, operation Action) {
+	for watch_key, _ := range r.watchedKeys {
+		if strings.HasPrefix(key, watch_key) {
+			glog.V(VERBOSE_LEVEL).Infof("Sending notification of change on key: %s, channel: %v", key, r.update_channel)
+			var event NodeChange
+			event.Node.Path = key
+			event.Node.Value = value
+			event.Operation = operation
+			go func() {
+				r.update_channel <- event
+			}()
+			return
+		}
+	}
+}
+
+func (r *MemoryStoreClient) createNode(path string, node *memoryNode) *Node {
+	return &Node{
+		Path:      path,
+		Value:     node.value,
+		Directory: node.isDir(),
+		Index:     node.index,
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMemoryClient(t *testing.T) (Store, NodeUpdateChannel) {
+	channel := make(NodeUpdateChannel, 10)
+	client, err := NewStore("memory://", channel)
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+	return client, channel
+}
+
+func TestMemorySetGet(t *testing.T) {
+	client, _ := newTestMemoryClient(t)
+	assert.Nil(t, client.Set("/test/key", "value"))
+	node, err := client.Get("test/key")
+	assert.Nil(t, err)
+	assert.Equal(t, "/test/key", node.Path)
+	assert.Equal(t, "value", node.Value)
+	assert.True(t, node.IsFile())
+	node, err = client.Get("/test")
+	assert.Nil(t, err)
+	assert.True(t, node.IsDir())
+	_, err = client.Get("/test/missing")
+	assert.Equal(t, KeyNotFoundErr, err)
+	// step: we can't overwrite a directory, or create beneath a file
+	assert.NotNil(t, client.Set("/test", "value"))
+	assert.NotNil(t, client.Set("/test/key/child", "value"))
+}
+
+func TestMemoryExistsDelete(t *testing.T) {
+	client, _ := newTestMemoryClient(t)
+	assert.Nil(t, client.Set("/test/key", "value"))
+	found, err := client.Exists("/test/key")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.NotNil(t, client.Delete("/test"))
+	assert.Nil(t, client.Delete("/test/key"))
+	found, err = client.Exists("/test/key")
+	assert.Nil(t, err)
+	assert.False(t, found)
+	assert.Equal(t, KeyNotFoundErr, client.Delete("/test/key"))
+}
+
+func TestMemoryListPaths(t *testing.T) {
+	client, _ := newTestMemoryClient(t)
+	assert.Nil(t, client.Set("/test/one", "1"))
+	assert.Nil(t, client.Set("/test/two", "2"))
+	assert.Nil(t, client.Set("/test/dir/three", "3"))
+	list, err := client.List("/test")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(list))
+	assert.Equal(t, "/test/dir", list[0].Path)
+	assert.True(t, list[0].IsDir())
+	_, err = client.List("/test/one")
+	assert.Equal(t, InvalidDirectoryErr, err)
+
+	paths := make([]string, 0)
+	_, err = client.Paths("/", &paths)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/test/dir/three", "/test/one", "/test/two"}, paths)
+
+	assert.Nil(t, client.RemovePath("/test/dir"))
+	list, err = client.List("/test")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(list))
+}
+
+func TestMemoryWatch(t *testing.T) {
+	client, updates := newTestMemoryClient(t)
+	client.Watch("/test")
+	assert.Nil(t, client.Set("/test/key", "value"))
+	select {
+	case event := <-updates:
+		assert.Equal(t, CHANGED, int(event.Operation))
+		assert.Equal(t, "/test/key", event.Node.Path)
+		assert.Equal(t, "value", event.Node.Value)
+	case <-time.After(time.Second):
+		assert.Fail(t, "we timed out waiting for an event")
+	}
+	assert.Nil(t, client.RemovePath("/test"))
+	select {
+	case event := <-updates:
+		assert.Equal(t, DELETED, int(event.Operation))
+		assert.Equal(t, "/test/key", event.Node.Path)
+	case <-time.After(time.Second):
+		assert.Fail(t, "we timed out waiting for an event")
+	}
+	client.Unwatch("/test")
+	assert.Nil(t, client.Set("/test/key", "value"))
+	select {
+	case <-updates:
+		assert.Fail(t, "we should not have recieved an event here")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestMemoryTTL(t *testing.T) {
+	client, updates := newTestMemoryClient(t)
+	client.Watch("/test")
+	assert.Nil(t, client.SetTTL("/test/ttl", "value", 200*time.Millisecond))
+	<-updates
+	// step: a refresh keeps the key alive past the original ttl
+	time.Sleep(100 * time.Millisecond)
+	assert.Nil(t, client.Refresh("/test/ttl", 200*time.Millisecond))
+	time.Sleep(150 * time.Millisecond)
+	found, err := client.Exists("/test/ttl")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	// step: without a refresh the key expires
+	select {
+	case event := <-updates:
+		assert.Equal(t, DELETED, int(event.Operation))
+		assert.Equal(t, "/test/ttl", event.Node.Path)
+	case <-time.After(time.Second):
+		assert.Fail(t, "we timed out waiting for the key to expire")
+	}
+	assert.Equal(t, KeyNotFoundErr, client.Refresh("/test/ttl", time.Second))
+	// step: a key set without a ttl has nothing to refresh
+	assert.Nil(t, client.Set("/test/plain", "value"))
+	assert.Equal(t, KeyNotFoundErr, client.Refresh("/test/plain", time.Second))
+}
+
+func TestMemoryCompareAndSet(t *testing.T) {
+	client, _ := newTestMemoryClient(t)
+	// step: an index of zero only creates the key
+	assert.Nil(t, client.CompareAndSet("/test/cas", "1", 0, 0))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet("/test/cas", "2", 0, 0))
+	node, err := client.Get("/test/cas")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", node.Value)
+	assert.True(t, node.Index > 0)
+	// step: the index must match the last change to the key
+	assert.Nil(t, client.CompareAndSet("/test/cas", "2", node.Index, 0))
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet("/test/cas", "3", node.Index, 0))
+	current, err := client.Get("/test/cas")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", current.Value)
+	assert.NotEqual(t, node.Index, current.Index)
+	assert.Equal(t, KeyModifiedErr, client.CompareAndSet("/test/missing", "1", current.Index, 0))
+	// step: a ttl expires the key as with SetTTL
+	assert.Nil(t, client.CompareAndSet("/test/cas", "4", current.Index, 100*time.Millisecond))
+	time.Sleep(200 * time.Millisecond)
+	found, err := client.Exists("/test/cas")
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,23 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"github.com/gambol99/config-hook/metrics"
+)
+
+var (
+	watchReconnects = metrics.RegisterCounter("config_hook_store_watch_reconnects_total",
+		"The number of times a watch on the store has had to be re-established", "backend")
+)
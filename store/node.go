@@ -41,10 +41,12 @@ type Node struct {
 	Value string
 	/* the type of node it is, directory or file */
 	Directory bool
+	/* the modify index of the node, used to compare and set */
+	Index uint64
 }
 
 func (n Node) String() string {
-	return fmt.Sprintf("path: %s, value: %s, type: %s", n.Path, n.Value, n.Directory)
+	return fmt.Sprintf("path: %s, value: %s, directory: %t", n.Path, n.Value, n.Directory)
 }
 
 func (n Node) IsDir() bool {
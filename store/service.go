@@ -16,6 +16,7 @@ package store
 import (
 	"errors"
 	"net/url"
+	"time"
 
 	"github.com/golang/glog"
 )
@@ -33,8 +34,16 @@ type Store interface {
 	Unwatch(key string)
 	/* Get a list of all the nodes under the path */
 	List(path string) ([]*Node, error)
+	/* check if a key exists in the store */
+	Exists(key string) (bool, error)
 	/* set a key in the store */
 	Set(key string, value string) error
+	/* set a key in the store which expires unless refreshed within the ttl */
+	SetTTL(key string, value string, ttl time.Duration) error
+	/* set the key only if it's unchanged since the index was read, zero if it must not exist; KeyModifiedErr if it has changed */
+	CompareAndSet(key string, value string, index uint64, ttl time.Duration) error
+	/* refresh the ttl of a key set with SetTTL, KeyNotFoundErr if it has expired */
+	Refresh(key string, ttl time.Duration) error
 	/* delete a key from the store */
 	Delete(key string) error
 	/* recursively delete a path */
@@ -46,6 +55,8 @@ type Store interface {
 var (
 	InvalidUrlErr       = errors.New("Invalid URI error, please check backend url")
 	InvalidDirectoryErr = errors.New("Invalid directory specified")
+	KeyNotFoundErr      = errors.New("The key does not exist in the store")
+	KeyModifiedErr      = errors.New("The key has been modified in the store")
 )
 
 func NewStore(location string, channel NodeUpdateChannel) (Store, error) {
@@ -67,7 +78,26 @@ func NewStore(location string, channel NodeUpdateChannel) (Store, error) {
 			} else {
 				return agent, nil
 			}
+		case "etcd3":
+			if agent, err := NewEtcd3StoreClient(uri, channel); err != nil {
+				glog.Errorf("Failed to create the Etcd v3 Store agent, error: %s", err)
+			} else {
+				return agent, nil
+			}
+		case "memory":
+			return NewMemoryStoreClient(uri, channel)
+		case "consul":
+			if agent, err := NewConsulStoreClient(uri, channel); err != nil {
+				glog.Errorf("Failed to create the Consul Store agent, error: %s", err)
+			} else {
+				return agent, nil
+			}
 		}
 		return nil, errors.New("Invalid location specified, the agent provider is not supported")
 	}
 }
+
+/* converts the ttl into whole seconds, rounding up so a key never expires early */
+func ttlSeconds(ttl time.Duration) int64 {
+	return int64((ttl + time.Second - 1) / time.Second)
+}
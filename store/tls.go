@@ -0,0 +1,44 @@
+/*
+Copyright 2014 Rohith All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+
+	"github.com/gambol99/config-hook/config"
+)
+
+/* creates the tls configuration for a backend from the ca and, optionally, the client certificate */
+func newTLSConfig(options config.TLSOptions) (*tls.Config, error) {
+	tls_config := &tls.Config{}
+	ca, err := ioutil.ReadFile(options.CACert_File)
+	if err != nil {
+		return nil, err
+	}
+	tls_config.RootCAs = x509.NewCertPool()
+	if !tls_config.RootCAs.AppendCertsFromPEM(ca) {
+		return nil, errors.New("unable to parse the ca certificate file: " + options.CACert_File)
+	}
+	if options.Cert_File != "" {
+		certificate, err := tls.LoadX509KeyPair(options.Cert_File, options.Key_File)
+		if err != nil {
+			return nil, err
+		}
+		tls_config.Certificates = []tls.Certificate{certificate}
+	}
+	return tls_config, nil
+}
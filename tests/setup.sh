@@ -5,7 +5,7 @@
 #
 #  vim:ts=2:sw=2:et
 #
-ETCD_VERSION="v2.0.0"
+ETCD_VERSION="v3.5.13"
 ETCD_DIR="/opt/etcd*"
 WORKDIR="/go/src/github.com/gambol99/config-hook"
 
@@ -20,7 +20,7 @@ failed() {
 
 say "Starting Etcd Service"
 cd /opt/etcd-${ETCD_VERSION}-linux-amd64 || failed "Unable to move into etcd directory"
-./etcd &
+./etcd --enable-v2 --data-dir=/tmp/etcd --listen-client-urls=http://127.0.0.1:2379,http://127.0.0.1:4001 --advertise-client-urls=http://127.0.0.1:2379 &
 [ $? -ne 0 ] && failed "Unable to start the Etcd Service"
 
 sleep 2
@@ -6,8 +6,8 @@
 #  vim:ts=2:sw=2:et
 #
 
-ETCD_PACKAGE="https://github.com/coreos/etcd/releases/download"
-ETCD_VERSION="v2.0.0"
+ETCD_PACKAGE="https://github.com/etcd-io/etcd/releases/download"
+ETCD_VERSION="v3.5.13"
 ETCD_URL="${ETCD_PACKAGE}/${ETCD_VERSION}/etcd-${ETCD_VERSION}-linux-amd64.tar.gz"
 TEMPDIR=$(mktemp -d)
 
@@ -50,7 +50,8 @@ run() {
 setup_etcd() {
   download "${ETCD_URL}" "${TEMPDIR}/etcd.tar.gz"
   extract "${TEMPDIR}/etcd.tar.gz" "${TEMPDIR}"
-  run "${TEMPDIR}/etcd*/etcd -data-dir=/tmp -bind-addr=127.0.0.1:4001 >/dev/null 2>&1 &"
+  # the v2 api is served on 4001 and the v3 api on 2379
+  run "${TEMPDIR}/etcd*/etcd --enable-v2 --data-dir=${TEMPDIR}/data --listen-client-urls=http://127.0.0.1:2379,http://127.0.0.1:4001 --advertise-client-urls=http://127.0.0.1:2379 >/dev/null 2>&1 &"
 }
 
 main() {